@@ -0,0 +1,117 @@
+package lebig
+
+import (
+	"math/big"
+	"sync/atomic"
+)
+
+// Backend is the storage and arithmetic implementation behind an Int. Every
+// method has the semantics of the Int method of the same name. Operands of
+// another Backend are converted through Words, so backends can be mixed.
+type Backend interface {
+	// New returns a zero valued, unbounded Backend of the same kind.
+	New() Backend
+	// Name identifies the backend, "big" or "words".
+	Name() string
+
+	Width() uint
+	SetWidth(n uint)
+	Words() []uint64
+	SetWords(in []uint64)
+	BitLen() uint
+	ByteLen() uint
+	WordLen() uint
+	TrailingZeros() uint
+
+	SetBytes(in []byte)
+	SetUint64(in uint64)
+	Uint64() uint64
+	Bytes() []byte
+	AppendBytes(dst []byte) []byte
+	PutBytes(dst []byte) int
+	SetBig(x *big.Int)
+	Big() *big.Int
+	Text(base int) string
+
+	SmallShiftRight(sr uint)
+	SmallShiftLeft(sl uint)
+	ShiftLeft(sl uint)
+	ShiftRight(sl uint)
+	ArithShiftRight(sr uint)
+	SignExtend(fromBits, toBits uint)
+	Truncate(bits uint)
+
+	AndUint64(in uint64)
+	AndBytes(in []byte)
+	OrUint64(in uint64)
+	OrBytes(in []byte)
+	And(in Backend)
+	Or(in Backend)
+	Xor(in Backend)
+	XorUint64(in uint64)
+	XorBytes(in []byte)
+	AndNot(in Backend)
+	Not(width uint)
+	RotateLeft(n, width uint)
+	RotateRight(n, width uint)
+
+	Add(in Backend)
+	AddUint64(in uint64)
+	AddBytes(in []byte)
+	Sub(in Backend)
+	SubUint64(in uint64)
+	SubBytes(in []byte)
+	Mul(in Backend)
+	MulUint64(in uint64)
+	MulBytes(in []byte)
+	Div(in Backend)
+	Mod(in Backend)
+	DivMod(in Backend, mod Backend)
+	DivUint64(in uint64) uint64
+	ModUint64(in uint64) uint64
+
+	Bit(i uint) uint
+	SetBit(i uint, v uint)
+	ClearBit(i uint)
+	Field(hi, lo uint) Backend
+	FieldUint64(hi, lo uint) uint64
+	SetField(hi, lo uint, v Backend)
+	SetFieldUint64(hi, lo uint, v uint64)
+
+	Cmp(in Backend) int
+	CmpUint64(in uint64) int
+	IsZero() bool
+}
+
+var (
+	// BigBackend keeps the value in a math/big.Int.
+	BigBackend Backend = &bigInt{}
+	// WordsBackend keeps the value in a slice of 64 bit words.
+	WordsBackend Backend = &wordsInt{}
+)
+
+type backendHolder struct {
+	backend Backend
+}
+
+var defaultBackend atomic.Value
+
+func init() {
+	defaultBackend.Store(backendHolder{backend: buildDefaultBackend})
+}
+
+// SetDefaultBackend makes new Ints use b from now on. Ints that already hold
+// a value keep their backend. It panics if b is nil.
+func SetDefaultBackend(b Backend) {
+	if b == nil {
+		panic(errNilBackend)
+	}
+	defaultBackend.Store(backendHolder{backend: b})
+}
+
+// DefaultBackend returns the Backend used by new Ints.
+func DefaultBackend() Backend {
+	return defaultBackend.Load().(backendHolder).backend
+}
+
+const errNilBackend = "lebig: nil Backend"
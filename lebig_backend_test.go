@@ -0,0 +1,101 @@
+package lebig_test
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+var backends = []lebig.Backend{lebig.BigBackend, lebig.WordsBackend}
+
+func TestBackendMixed(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		aBytes, bBytes := randomBytes(100), randomBytes(100)
+		bBytes[0] |= 1
+		a, b := bigFromBytes(aBytes), bigFromBytes(bBytes)
+		for _, first := range backends {
+			for _, second := range backends {
+				anInt := lebig.NewWithBackend(first)
+				anInt.SetBytes(aBytes)
+				op := lebig.NewWithBackend(second)
+				op.SetBytes(bBytes)
+
+				sum := new(big.Int).Add(a, b)
+				anInt.Add(op)
+				checkSlices(t, bytesFromBig(sum), anInt.Bytes(), x)
+
+				product := new(big.Int).Mul(sum, b)
+				anInt.Mul(op)
+				checkSlices(t, bytesFromBig(product), anInt.Bytes(), x)
+
+				q, r := new(big.Int).QuoRem(product, new(big.Int).Add(a, big.NewInt(1)), new(big.Int))
+				mod := lebig.NewWithBackend(second)
+				divisor := lebig.NewWithBackend(second)
+				divisor.SetBytes(aBytes)
+				divisor.AddUint64(1)
+				anInt.DivMod(divisor, mod)
+				checkSlices(t, bytesFromBig(q), anInt.Bytes(), x)
+				checkSlices(t, bytesFromBig(r), mod.Bytes(), x)
+
+				if anInt.Backend().Name() != first.Name() || mod.Backend().Name() != second.Name() {
+					t.Errorf("backend changed: %s %s", anInt.Backend().Name(), mod.Backend().Name())
+				}
+				if anInt.Cmp(op) != q.Cmp(b) {
+					t.Errorf("%d: Cmp %s %s", x, first.Name(), second.Name())
+				}
+			}
+		}
+	}
+}
+
+func TestSetBackend(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300))
+		randBytes := randomBytes(50)
+		for _, from := range backends {
+			for _, to := range backends {
+				anInt := lebig.NewWithBackend(from)
+				anInt.SetWidth(width)
+				anInt.SetBytes(randBytes)
+				expected := anInt.Bytes()
+				anInt.SetBackend(to)
+				if anInt.Backend().Name() != to.Name() || anInt.Width() != width {
+					t.Fatalf("%d: got %s width %d, expected %s width %d", x, anInt.Backend().Name(), anInt.Width(), to.Name(), width)
+				}
+				checkSlices(t, expected, anInt.Bytes(), x)
+			}
+		}
+	}
+}
+
+func TestDefaultBackend(t *testing.T) {
+	t.Log(t.Name())
+	initial := lebig.DefaultBackend()
+	defer lebig.SetDefaultBackend(initial)
+	for _, backend := range backends {
+		lebig.SetDefaultBackend(backend)
+		if lebig.DefaultBackend().Name() != backend.Name() {
+			t.Errorf("got default %s, expected %s", lebig.DefaultBackend().Name(), backend.Name())
+		}
+		var zero lebig.Int
+		if zero.Backend().Name() != backend.Name() || lebig.NewWidth(8).Backend().Name() != backend.Name() {
+			t.Errorf("new Int does not use %s", backend.Name())
+		}
+	}
+	before := lebig.DefaultBackend()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for a nil Backend")
+		}
+		if lebig.DefaultBackend() != before {
+			t.Error("default changed by a nil Backend")
+		}
+	}()
+	lebig.SetDefaultBackend(nil)
+}
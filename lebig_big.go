@@ -0,0 +1,12 @@
+package lebig
+
+import (
+	"math/big"
+)
+
+// FromBig returns a new unbounded Int set to x. It panics if x is negative.
+func FromBig(x *big.Int) *Int {
+	anInt := &Int{}
+	anInt.SetBig(x)
+	return anInt
+}
@@ -0,0 +1,54 @@
+package lebig_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestBig(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(300)
+		aBigInt := bigFromBytes(randBytes)
+
+		anInt := lebig.FromBig(aBigInt)
+		checkSlices(t, lebig.RemoveMostSignificantZeroesFromBytes(randBytes), anInt.Bytes(), x)
+
+		outBig := anInt.Big()
+		if outBig.Cmp(aBigInt) != 0 {
+			t.Error("not Equal on repetition: ", x)
+			t.FailNow()
+		}
+
+		// neither side shares memory with the other
+		aBigInt.SetUint64(1)
+		outBig.SetUint64(2)
+		checkSlices(t, lebig.RemoveMostSignificantZeroesFromBytes(randBytes), anInt.Bytes(), x)
+	}
+}
+
+func TestSetBigWidth(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	aBigInt, _ := new(big.Int).SetString("123456789abcdef0123", 16)
+	anInt := lebig.NewWidth(16)
+	anInt.SetBig(aBigInt)
+	if anInt.Uint64() != 0x0123 || anInt.Big().Uint64() != 0x0123 {
+		t.Errorf("wrong truncated value %s", anInt)
+	}
+
+	zero := lebig.FromBig(new(big.Int))
+	if !zero.IsZero() || zero.Big().Sign() != 0 {
+		t.Error("zero round trip failed", zero)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic on negative big.Int")
+		}
+	}()
+	lebig.FromBig(big.NewInt(-1))
+}
@@ -0,0 +1,486 @@
+package lebig
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// bigInt is the Backend that keeps the value in a math/big.Int.
+type bigInt struct {
+	width uint
+	anInt big.Int
+}
+
+func (this *bigInt) New() Backend {
+	return &bigInt{}
+}
+
+func (this *bigInt) Name() string {
+	return "big"
+}
+
+// bigFrom returns in as a bigInt, converting the value of other backends.
+func bigFrom(in Backend) *bigInt {
+	if anInt, ok := in.(*bigInt); ok {
+		return anInt
+	}
+	anInt := &bigInt{}
+	anInt.setWords(in.Words())
+	return anInt
+}
+
+func (this *bigInt) Width() uint {
+	return this.width
+}
+
+func (this *bigInt) SetWidth(n uint) {
+	this.width = n
+	this.wrap()
+}
+
+// wrap reduces anInt modulo 2^width.
+func (this *bigInt) wrap() {
+	if this.width == 0 {
+		return
+	}
+	if this.anInt.Sign() < 0 {
+		modulus := big.Int{}
+		modulus.SetUint64(1)
+		modulus.Lsh(&modulus, this.width)
+		this.anInt.Mod(&this.anInt, &modulus)
+		return
+	}
+	if uint(this.anInt.BitLen()) <= this.width {
+		return
+	}
+	words := this.anInt.Bits()
+	top := this.width / bits.UintSize
+	words = words[0 : top+1]
+	words[top] &= 1<<(this.width%bits.UintSize) - 1
+	this.anInt.SetBits(words)
+}
+
+// SetBytes sets this to the little endian bytes in, reusing the words of
+// anInt.
+func (this *bigInt) SetBytes(in []byte) {
+	this.anInt.SetBits(bigWordsFromBytes(this.anInt.Bits(), in))
+	this.wrap()
+}
+
+func (this *bigInt) SetUint64(in uint64) {
+	this.anInt.SetUint64(in)
+	this.wrap()
+}
+
+func (this *bigInt) Uint64() uint64 {
+	return this.anInt.Uint64()
+}
+
+func (this *bigInt) Bytes() []byte {
+	return this.AppendBytes(make([]byte, 0, this.bytesLen()))
+}
+
+func (this *bigInt) AppendBytes(dst []byte) []byte {
+	dst, out := growBytes(dst, this.bytesLen())
+	putBigWordBytes(out, this.anInt.Bits())
+	return dst
+}
+
+func (this *bigInt) PutBytes(dst []byte) int {
+	size := this.bytesLen()
+	if len(dst) < size {
+		panic(errFillBytes)
+	}
+	putBigWordBytes(dst[:size], this.anInt.Bits())
+	return size
+}
+
+// bytesLen is the length of Bytes, ceil(width/8) for a fixed width Int.
+func (this *bigInt) bytesLen() int {
+	if this.width > 0 {
+		return int(sizeInBytes(this.width))
+	}
+	return (this.anInt.BitLen() + 7) / 8
+}
+
+func (this *bigInt) SmallShiftRight(sr uint) {
+	this.anInt.Rsh(&this.anInt, sr)
+}
+
+func (this *bigInt) SmallShiftLeft(sl uint) {
+	this.anInt.Lsh(&this.anInt, sl)
+	this.wrap()
+}
+
+func (this *bigInt) ShiftLeft(sl uint) {
+	this.anInt.Lsh(&this.anInt, sl)
+	this.wrap()
+}
+
+func (this *bigInt) ShiftRight(sl uint) {
+	this.anInt.Rsh(&this.anInt, sl)
+}
+
+func (this *bigInt) ArithShiftRight(sr uint) {
+	if this.width == 0 {
+		panic(errSignedUnbounded)
+	}
+	negative := this.anInt.Bit(int(this.width-1)) == 1
+	this.anInt.Rsh(&this.anInt, sr)
+	if negative {
+		lo := uint(0)
+		if sr < this.width {
+			lo = this.width - sr
+		}
+		this.anInt.Or(&this.anInt, onesBig(lo, this.width))
+	}
+}
+
+func (this *bigInt) SignExtend(fromBits, toBits uint) {
+	checkSignExtend(fromBits, toBits)
+	this.width = fromBits
+	this.wrap()
+	this.width = toBits
+	if this.anInt.Bit(int(fromBits-1)) == 1 {
+		this.anInt.Or(&this.anInt, onesBig(fromBits, toBits))
+	}
+}
+
+func (this *bigInt) Truncate(bits uint) {
+	checkTruncate(this.width, bits)
+	this.SetWidth(bits)
+}
+
+// onesBig returns a big.Int with bits [hi-1:lo] set.
+func onesBig(lo, hi uint) *big.Int {
+	ones := big.NewInt(1)
+	ones.Lsh(ones, hi-lo)
+	ones.Sub(ones, big.NewInt(1))
+	return ones.Lsh(ones, lo)
+}
+
+func (this *bigInt) AndUint64(in uint64) {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.anInt.And(&this.anInt, &op)
+}
+
+func (this *bigInt) newBigIntFromBytes(in []byte) *big.Int {
+	newBytes := make([]byte, len(in))
+	copy(newBytes, in)
+	ReverseSliceOfBytes(newBytes)
+	newBigInt := big.Int{}
+	newBigInt.SetBytes(newBytes)
+	return &newBigInt
+}
+
+func (this *bigInt) AndBytes(in []byte) {
+	op := this.newBigIntFromBytes(in)
+	this.anInt.And(&this.anInt, op)
+}
+
+func (this *bigInt) OrUint64(in uint64) {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.anInt.Or(&this.anInt, &op)
+	this.wrap()
+}
+
+func (this *bigInt) OrBytes(in []byte) {
+	op := this.newBigIntFromBytes(in)
+	this.anInt.Or(&this.anInt, op)
+	this.wrap()
+}
+
+func (this *bigInt) Add(in Backend) {
+	this.anInt.Add(&this.anInt, &bigFrom(in).anInt)
+	this.wrap()
+}
+
+func (this *bigInt) AddUint64(in uint64) {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.anInt.Add(&this.anInt, &op)
+	this.wrap()
+}
+
+func (this *bigInt) AddBytes(in []byte) {
+	op := this.newBigIntFromBytes(in)
+	this.anInt.Add(&this.anInt, op)
+	this.wrap()
+}
+
+func (this *bigInt) sub(op *big.Int) {
+	if this.width == 0 && this.anInt.Cmp(op) < 0 {
+		panic(errSubUnderflow)
+	}
+	this.anInt.Sub(&this.anInt, op)
+	this.wrap()
+}
+
+func (this *bigInt) Sub(in Backend) {
+	this.sub(&bigFrom(in).anInt)
+}
+
+func (this *bigInt) SubUint64(in uint64) {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.sub(&op)
+}
+
+func (this *bigInt) SubBytes(in []byte) {
+	op := this.newBigIntFromBytes(in)
+	this.sub(op)
+}
+
+func (this *bigInt) Mul(in Backend) {
+	this.anInt.Mul(&this.anInt, &bigFrom(in).anInt)
+	this.wrap()
+}
+
+func (this *bigInt) MulUint64(in uint64) {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.anInt.Mul(&this.anInt, &op)
+	this.wrap()
+}
+
+func (this *bigInt) MulBytes(in []byte) {
+	op := this.newBigIntFromBytes(in)
+	this.anInt.Mul(&this.anInt, op)
+	this.wrap()
+}
+
+func (this *bigInt) checkDivisor(in *big.Int) {
+	if in.Sign() == 0 {
+		panic(errDivisionByZero)
+	}
+}
+
+func (this *bigInt) Div(in Backend) {
+	op := &bigFrom(in).anInt
+	this.checkDivisor(op)
+	this.anInt.Quo(&this.anInt, op)
+}
+
+func (this *bigInt) Mod(in Backend) {
+	op := &bigFrom(in).anInt
+	this.checkDivisor(op)
+	this.anInt.Rem(&this.anInt, op)
+}
+
+func (this *bigInt) DivMod(in Backend, mod Backend) {
+	op := &bigFrom(in).anInt
+	this.checkDivisor(op)
+	q, r := big.Int{}, big.Int{}
+	q.QuoRem(&this.anInt, op, &r)
+	this.anInt.Set(&q)
+	mod.SetBig(&r)
+}
+
+func (this *bigInt) DivUint64(in uint64) uint64 {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.checkDivisor(&op)
+	r := big.Int{}
+	this.anInt.QuoRem(&this.anInt, &op, &r)
+	return r.Uint64()
+}
+
+func (this *bigInt) ModUint64(in uint64) uint64 {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.checkDivisor(&op)
+	r := big.Int{}
+	r.Rem(&this.anInt, &op)
+	return r.Uint64()
+}
+
+func (this *bigInt) And(in Backend) {
+	this.anInt.And(&this.anInt, &bigFrom(in).anInt)
+}
+
+func (this *bigInt) Or(in Backend) {
+	this.anInt.Or(&this.anInt, &bigFrom(in).anInt)
+	this.wrap()
+}
+
+func (this *bigInt) Xor(in Backend) {
+	this.anInt.Xor(&this.anInt, &bigFrom(in).anInt)
+	this.wrap()
+}
+
+func (this *bigInt) XorUint64(in uint64) {
+	op := big.Int{}
+	op.SetUint64(in)
+	this.anInt.Xor(&this.anInt, &op)
+	this.wrap()
+}
+
+func (this *bigInt) XorBytes(in []byte) {
+	op := this.newBigIntFromBytes(in)
+	this.anInt.Xor(&this.anInt, op)
+	this.wrap()
+}
+
+func (this *bigInt) AndNot(in Backend) {
+	this.anInt.AndNot(&this.anInt, &bigFrom(in).anInt)
+}
+
+func (this *bigInt) Not(width uint) {
+	if width == 0 {
+		width = this.width
+	}
+	if width == 0 {
+		panic(errNotUnbounded)
+	}
+	mask := big.Int{}
+	mask.SetUint64(1)
+	mask.Lsh(&mask, width)
+	mask.Sub(&mask, big.NewInt(1))
+	this.anInt.AndNot(&mask, &this.anInt)
+	this.wrap()
+}
+
+func (this *bigInt) RotateLeft(n, width uint) {
+	width = rotateWidth(width, this.width)
+	n %= width
+	mask := onesBig(0, width)
+	value := new(big.Int).And(&this.anInt, mask)
+	wrapped := new(big.Int).Rsh(value, width-n)
+	this.anInt.Lsh(value, n)
+	this.anInt.And(&this.anInt, mask)
+	this.anInt.Or(&this.anInt, wrapped)
+	this.wrap()
+}
+
+func (this *bigInt) RotateRight(n, width uint) {
+	width = rotateWidth(width, this.width)
+	this.RotateLeft(width-n%width, width)
+}
+
+func (this *bigInt) Bit(i uint) uint {
+	// big.Int takes an int index, check the size before converting
+	if i >= uint(this.anInt.BitLen()) {
+		return 0
+	}
+	return this.anInt.Bit(int(i))
+}
+
+func (this *bigInt) SetBit(i uint, v uint) {
+	if v > 1 {
+		panic(errBitValue)
+	}
+	if v == 0 {
+		this.ClearBit(i)
+		return
+	}
+	if this.width > 0 && i >= this.width {
+		return
+	}
+	this.anInt.SetBit(&this.anInt, int(i), v)
+}
+
+func (this *bigInt) ClearBit(i uint) {
+	if i >= uint(this.anInt.BitLen()) {
+		return
+	}
+	this.anInt.SetBit(&this.anInt, int(i), 0)
+}
+
+func (this *bigInt) Field(hi, lo uint) Backend {
+	field := &bigInt{width: fieldWidth(hi, lo)}
+	field.anInt.Rsh(&this.anInt, lo)
+	field.wrap()
+	return field
+}
+
+func (this *bigInt) FieldUint64(hi, lo uint) uint64 {
+	if fieldWidth(hi, lo) > 64 {
+		panic(errFieldTooWide)
+	}
+	return this.Field(hi, lo).Uint64()
+}
+
+func (this *bigInt) SetField(hi, lo uint, v Backend) {
+	field := &bigInt{width: fieldWidth(hi, lo)}
+	field.anInt.Set(&bigFrom(v).anInt)
+	field.wrap()
+	field.anInt.Lsh(&field.anInt, lo)
+
+	mask := big.Int{}
+	mask.SetUint64(1)
+	mask.Lsh(&mask, field.width)
+	mask.Sub(&mask, big.NewInt(1))
+	mask.Lsh(&mask, lo)
+
+	this.anInt.AndNot(&this.anInt, &mask)
+	this.anInt.Or(&this.anInt, &field.anInt)
+	this.wrap()
+}
+
+func (this *bigInt) SetFieldUint64(hi, lo uint, v uint64) {
+	op := bigInt{}
+	op.SetUint64(v)
+	this.SetField(hi, lo, &op)
+}
+
+func (this *bigInt) Cmp(in Backend) int {
+	return this.anInt.Cmp(&bigFrom(in).anInt)
+}
+
+func (this *bigInt) CmpUint64(in uint64) int {
+	op := big.Int{}
+	op.SetUint64(in)
+	return this.anInt.Cmp(&op)
+}
+
+func (this *bigInt) IsZero() bool {
+	return this.anInt.Sign() == 0
+}
+
+func (this *bigInt) Text(base int) string {
+	checkBase(base)
+	return this.anInt.Text(base)
+}
+
+func (this *bigInt) setWords(words []uint64) {
+	this.anInt.SetBits(bigWordsFromWords(words))
+	this.wrap()
+}
+
+func (this *bigInt) SetBig(x *big.Int) {
+	if x.Sign() < 0 {
+		panic(errNegativeBig)
+	}
+	this.anInt.Set(x)
+	this.wrap()
+}
+
+func (this *bigInt) Big() *big.Int {
+	return new(big.Int).Set(&this.anInt)
+}
+
+func (this *bigInt) Words() []uint64 {
+	return wordsFromBigWords(this.anInt.Bits())
+}
+
+func (this *bigInt) SetWords(in []uint64) {
+	this.setWords(in)
+}
+
+func (this *bigInt) BitLen() uint {
+	return uint(this.anInt.BitLen())
+}
+
+func (this *bigInt) ByteLen() uint {
+	return sizeInBytes(this.BitLen())
+}
+
+func (this *bigInt) WordLen() uint {
+	return sizeInWordsFromBits(this.BitLen())
+}
+
+func (this *bigInt) TrailingZeros() uint {
+	return this.anInt.TrailingZeroBits()
+}
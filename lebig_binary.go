@@ -0,0 +1,80 @@
+package lebig
+
+import (
+	"encoding/binary"
+)
+
+// The binary wire format is
+//
+//	version  byte, binaryVersion
+//	flags    byte, binaryFlagWidth when a width follows
+//	width    uvarint, only for fixed width values
+//	length   uvarint, number of payload bytes
+//	payload  little endian bytes of the value, empty for 0
+const (
+	binaryVersion   = 1
+	binaryFlagWidth = 1 << 0
+)
+
+// MarshalBinary implements encoding.BinaryMarshaler, and therefore gob,
+// producing the same bytes for the same value in every backend.
+func (this *Int) MarshalBinary() ([]byte, error) {
+	var payload []byte
+	if !this.IsZero() {
+		payload = RemoveMostSignificantZeroesFromBytes(this.Bytes())
+	}
+
+	out := make([]byte, 2, 2+2*binary.MaxVarintLen64+len(payload))
+	out[0] = binaryVersion
+	if this.Width() > 0 {
+		out[1] |= binaryFlagWidth
+		out = appendUvarint(out, uint64(this.Width()))
+	}
+	out = appendUvarint(out, uint64(len(payload)))
+	return append(out, payload...), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. This takes the
+// width stored in data, or becomes unbounded when data has none.
+func (this *Int) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return ErrMalformed
+	}
+	if data[0] != binaryVersion {
+		return ErrUnsupportedVersion
+	}
+	flags := data[1]
+	if flags&^binaryFlagWidth != 0 {
+		return ErrMalformed
+	}
+	data = data[2:]
+
+	width := uint64(0)
+	if flags&binaryFlagWidth != 0 {
+		var n int
+		width, n = binary.Uvarint(data)
+		if n <= 0 || width == 0 || width > MaxWidth {
+			return ErrMalformed
+		}
+		data = data[n:]
+	}
+
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length != uint64(len(data)-n) {
+		return ErrMalformed
+	}
+	payload := data[n:]
+	if width > 0 && bytesOverflow(payload, uint(width)) {
+		return ErrOverflow
+	}
+
+	this.SetWidth(uint(width))
+	this.SetBytes(payload)
+	return nil
+}
+
+func appendUvarint(dst []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(dst, buf[:n]...)
+}
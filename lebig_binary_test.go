@@ -0,0 +1,136 @@
+package lebig_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(300)
+		anInt := lebig.Int{}
+		if rand.Intn(2) == 0 {
+			anInt.SetWidth(uint(len(randBytes)*8 + rand.Intn(64)))
+		}
+		anInt.SetBytes(randBytes)
+
+		data, err := anInt.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		outInt := lebig.NewWidth(7)
+		if err := outInt.UnmarshalBinary(data); err != nil {
+			t.Error("UnmarshalBinary failed on repetition: ", x, err)
+			t.FailNow()
+		}
+		if outInt.Width() != anInt.Width() || !outInt.Equal(&anInt) {
+			t.Error("not Equal on repetition: ", x, outInt.Width(), anInt.Width())
+			t.FailNow()
+		}
+	}
+}
+
+func TestMarshalBinaryWireFormat(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.Int{}
+	anInt.SetUint64(0x0102)
+	fixedInt := lebig.NewWidth(300)
+	fixedInt.SetUint64(0x0102)
+
+	cases := []struct {
+		value    *lebig.Int
+		expected []byte
+	}{
+		{&lebig.Int{}, []byte{1, 0, 0}},
+		{&anInt, []byte{1, 0, 2, 0x02, 0x01}},
+		{fixedInt, []byte{1, 1, 0xAC, 0x02, 2, 0x02, 0x01}},
+	}
+	for _, c := range cases {
+		data, _ := c.value.MarshalBinary()
+		if !reflect.DeepEqual(data, c.expected) {
+			t.Errorf("got %v, expected %v", data, c.expected)
+		}
+	}
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	cases := []struct {
+		data     []byte
+		expected error
+	}{
+		{nil, lebig.ErrMalformed},
+		{[]byte{1}, lebig.ErrMalformed},
+		{[]byte{2, 0, 0}, lebig.ErrUnsupportedVersion},
+		{[]byte{1, 2, 0}, lebig.ErrMalformed},
+		{[]byte{1, 0}, lebig.ErrMalformed},
+		{[]byte{1, 0, 3, 1, 2}, lebig.ErrMalformed},
+		{[]byte{1, 0, 1, 1, 2}, lebig.ErrMalformed},
+		{[]byte{1, 0, 0x80}, lebig.ErrMalformed},
+		{[]byte{1, 1, 0, 0}, lebig.ErrMalformed},
+		{[]byte{1, 1}, lebig.ErrMalformed},
+		{[]byte{1, 1, 4, 1, 0x10}, lebig.ErrOverflow},
+		{[]byte{1, 1, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x40, 1, 5}, lebig.ErrMalformed},
+		{[]byte{1, 1, 0x81, 0x80, 0x80, 0x80, 0x04, 1, 5}, lebig.ErrMalformed},
+		{[]byte{1, 1, 0x80, 0x02, 1, 5}, nil},
+	}
+	for _, c := range cases {
+		anInt := lebig.Int{}
+		anInt.SetUint64(42)
+		err := anInt.UnmarshalBinary(c.data)
+		if err != c.expected {
+			t.Errorf("%v: got %v, expected %v", c.data, err, c.expected)
+		}
+		if err == nil {
+			if len(anInt.Bytes()) != int(anInt.Width()+7)/8 || anInt.Uint64() != 5 {
+				t.Errorf("%v: decoded %d bytes of width %d", c.data, len(anInt.Bytes()), anInt.Width())
+			}
+		} else if anInt.Uint64() != 42 {
+			t.Errorf("%v: value changed on error", c.data)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	type snapshot struct {
+		Name   string
+		Value  *lebig.Int
+		Values []*lebig.Int
+	}
+	in := snapshot{Name: "regs", Value: lebig.NewWidth(96)}
+	in.Value.SetBytes(randomBytes(12))
+	for i := 0; i < 10; i++ {
+		anInt := &lebig.Int{}
+		anInt.SetBytes(randomBytes(100))
+		in.Values = append(in.Values, anInt)
+	}
+
+	buf := bytes.Buffer{}
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatal(err)
+	}
+	out := snapshot{}
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+
+	if out.Name != in.Name || out.Value.Width() != 96 || !out.Value.Equal(in.Value) || len(out.Values) != len(in.Values) {
+		t.Fatal("gob round trip failed", out.Value, in.Value)
+	}
+	for i := range in.Values {
+		if !out.Values[i].Equal(in.Values[i]) {
+			t.Error("not Equal at index ", i)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+//go:build !internal
+// +build !internal
+
+package lebig
+
+var buildDefaultBackend = BigBackend
@@ -0,0 +1,71 @@
+package lebig
+
+import (
+	"encoding/binary"
+)
+
+const errFillBytes = "lebig: buffer too small to fit value"
+
+// SetBytesBE sets this to the big endian bytes in.
+func (this *Int) SetBytesBE(in []byte) {
+	newIn := make([]byte, len(in))
+	copy(newIn, in)
+	ReverseSliceOfBytes(newIn)
+	this.SetBytes(newIn)
+}
+
+// BytesBE returns the big endian bytes of this, which for a fixed width Int
+// are exactly ceil(width/8) bytes.
+func (this *Int) BytesBE() []byte {
+	out := this.Bytes()
+	ReverseSliceOfBytes(out)
+	return out
+}
+
+// SetWords32 sets this to words in order, binary.LittleEndian puts the least
+// significant word first and binary.BigEndian the most significant word.
+func (this *Int) SetWords32(words []uint32, order binary.ByteOrder) {
+	buf := make([]byte, 4*len(words))
+	for i, word := range words {
+		order.PutUint32(buf[4*i:], word)
+	}
+	if isBigEndian(order) {
+		this.SetBytesBE(buf)
+	} else {
+		this.SetBytes(buf)
+	}
+}
+
+// Words32 returns this as 32 bit words, least significant word first.
+func (this *Int) Words32() []uint32 {
+	in := this.Bytes()
+	out := make([]uint32, (len(in)+3)/4)
+	for i, b := range in {
+		out[i/4] |= uint32(b) << (8 * uint(i%4))
+	}
+	return out
+}
+
+// FillBytes writes this to all of buf in order, padding with zeros, and
+// returns buf. It panics if this does not fit in buf.
+func (this *Int) FillBytes(buf []byte, order binary.ByteOrder) []byte {
+	in := RemoveMostSignificantZeroesFromBytes(this.Bytes())
+	if this.IsZero() {
+		in = in[0:0]
+	}
+	if len(in) > len(buf) {
+		panic(errFillBytes)
+	}
+	for i := range buf {
+		buf[i] = 0
+	}
+	copy(buf, in)
+	if isBigEndian(order) {
+		ReverseSliceOfBytes(buf)
+	}
+	return buf
+}
+
+func isBigEndian(order binary.ByteOrder) bool {
+	return order.Uint16([]byte{0, 1}) == 1
+}
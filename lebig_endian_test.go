@@ -0,0 +1,94 @@
+package lebig_test
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestBytesBE(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(300)
+		aBigInt := bigFromBytes(randBytes)
+
+		anInt := lebig.Int{}
+		anInt.SetBytesBE(aBigInt.Bytes())
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+		checkSlices(t, aBigInt.Bytes(), anInt.BytesBE(), x)
+	}
+
+	fixedInt := lebig.NewWidth(24)
+	fixedInt.SetBytesBE([]byte{0xAA, 0x01, 0x02, 0x03})
+	checkSlices(t, []byte{0x01, 0x02, 0x03}, fixedInt.BytesBE(), 0)
+	fixedInt.SetUint64(1)
+	checkSlices(t, []byte{0x00, 0x00, 0x01}, fixedInt.BytesBE(), 0)
+}
+
+func TestWords32(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		words := make([]uint32, rand.Intn(50)+1)
+		for i := range words {
+			words[i] = rand.Uint32()
+		}
+		words[len(words)-1] |= 1
+
+		littleInt := lebig.Int{}
+		littleInt.SetWords32(words, binary.LittleEndian)
+		if out := littleInt.Words32(); !reflect.DeepEqual(out, words) {
+			t.Error("little endian not Equal on repetition: ", x)
+			t.Error(out)
+			t.Error(words)
+			t.FailNow()
+		}
+
+		reversed := make([]uint32, len(words))
+		for i := range words {
+			reversed[len(words)-1-i] = words[i]
+		}
+		bigInt := lebig.Int{}
+		bigInt.SetWords32(reversed, binary.BigEndian)
+		if !bigInt.Equal(&littleInt) {
+			t.Error("big endian not Equal on repetition: ", x)
+			t.FailNow()
+		}
+	}
+
+	anInt := lebig.Int{}
+	anInt.SetWords32([]uint32{0x11223344, 0x55667788}, binary.BigEndian)
+	if anInt.Uint64() != 0x1122334455667788 {
+		t.Errorf("wrong value %x", anInt.Uint64())
+	}
+	if out := lebig.NewWidth(70).Words32(); len(out) != 3 {
+		t.Error("wrong fixed width length", out)
+	}
+}
+
+func TestFillBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.Int{}
+	anInt.SetUint64(0x010203)
+
+	buf := []byte{9, 9, 9, 9, 9}
+	checkSlices(t, []byte{3, 2, 1, 0, 0}, anInt.FillBytes(buf, binary.LittleEndian), 0)
+	checkSlices(t, []byte{0, 0, 1, 2, 3}, anInt.FillBytes(buf, binary.BigEndian), 0)
+	checkSlices(t, []byte{0, 0, 0, 0, 0}, new(lebig.Int).FillBytes(buf, binary.BigEndian), 0)
+
+	fixedInt := lebig.NewWidth(64)
+	fixedInt.SetUint64(0x0102)
+	checkSlices(t, []byte{1, 2}, fixedInt.FillBytes(make([]byte, 2), binary.BigEndian), 0)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic on a short buffer")
+		}
+	}()
+	anInt.FillBytes(make([]byte, 2), binary.LittleEndian)
+}
@@ -0,0 +1,55 @@
+package lebig
+
+import (
+	"errors"
+)
+
+// MaxWidth is the largest width accepted from encoded or parsed input, so
+// that a corrupted width header can not make Bytes allocate without bound.
+const MaxWidth = 1 << 30
+
+var (
+	// ErrEmptyInput is returned when a value is built from an empty slice,
+	// which usually means the input was truncated.
+	ErrEmptyInput = errors.New("lebig: empty input")
+
+	// ErrOverflow is returned when a value does not fit in the width of a
+	// fixed width Int.
+	ErrOverflow = errors.New("lebig: value overflows width")
+
+	// ErrUnsupportedVersion is returned when decoding a binary value written
+	// with an unknown version of the wire format.
+	ErrUnsupportedVersion = errors.New("lebig: unsupported binary version")
+
+	// ErrMalformed is returned when decoding a truncated or corrupted
+	// binary value.
+	ErrMalformed = errors.New("lebig: malformed binary value")
+
+	// ErrNegative is returned when a negative value is decoded into an Int
+	// without a width to hold its two's complement.
+	ErrNegative = errors.New("lebig: negative value needs a width")
+)
+
+// SetBytesErr is like SetBytes but reports an empty input with ErrEmptyInput
+// and an input that does not fit in the width of this with ErrOverflow. In
+// both cases this is still set, to 0 or to the truncated value respectively.
+func (this *Int) SetBytesErr(in []byte) error {
+	this.SetBytes(in)
+	if len(in) == 0 {
+		return ErrEmptyInput
+	}
+	if this.Width() > 0 && bytesOverflow(in, this.Width()) {
+		return ErrOverflow
+	}
+	return nil
+}
+
+// FromBytes returns a new Int set from the little endian bytes in. It
+// returns ErrEmptyInput instead of an Int when in is empty.
+func FromBytes(in []byte) (*Int, error) {
+	anInt := &Int{}
+	if err := anInt.SetBytesErr(in); err != nil {
+		return nil, err
+	}
+	return anInt, nil
+}
@@ -0,0 +1,46 @@
+package lebig_test
+
+import (
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestFromBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt, err := lebig.FromBytes([]byte{0x34, 0x12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anInt.Uint64() != 0x1234 {
+		t.Errorf("wrong value %x", anInt.Uint64())
+	}
+
+	if _, err := lebig.FromBytes([]byte{}); err != lebig.ErrEmptyInput {
+		t.Error("expected ErrEmptyInput, got", err)
+	}
+	if _, err := lebig.FromBytes(nil); err != lebig.ErrEmptyInput {
+		t.Error("expected ErrEmptyInput, got", err)
+	}
+}
+
+func TestSetBytesErr(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.NewWidth(12)
+	if err := anInt.SetBytesErr([]byte{0xFF, 0x0F, 0x00}); err != nil {
+		t.Error("unexpected error", err)
+	}
+	if err := anInt.SetBytesErr([]byte{0xFF, 0x1F}); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow, got", err)
+	}
+	checkSlices(t, []byte{0xFF, 0x0F}, anInt.Bytes(), 0)
+
+	if err := anInt.SetBytesErr(nil); err != lebig.ErrEmptyInput {
+		t.Error("expected ErrEmptyInput, got", err)
+	}
+	if !anInt.IsZero() {
+		t.Error("empty input is not zero", anInt.Bytes())
+	}
+}
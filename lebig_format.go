@@ -0,0 +1,106 @@
+package lebig
+
+import (
+	"fmt"
+	"strings"
+)
+
+// String returns the decimal digits of this. It and Format have value
+// receivers, so an Int prints the same as a *Int.
+func (this Int) String() string {
+	return this.Text(10)
+}
+
+// Format implements fmt.Formatter with the same verbs and flags as big.Int:
+// %b, %o, %O, %d, %s, %v, %x and %X, the '#', '+', ' ', '-' and '0' flags,
+// width and precision. The digits of a fixed width Int in base 2, 8 and 16
+// are zero padded to its width unless a precision is given.
+func (this Int) Format(s fmt.State, ch rune) {
+	var base int
+	switch ch {
+	case 'b':
+		base = 2
+	case 'o', 'O':
+		base = 8
+	case 'd', 's', 'v':
+		base = 10
+	case 'x', 'X':
+		base = 16
+	default:
+		fmt.Fprintf(s, "%%!%c(lebig.Int=%s)", ch, this.String())
+		return
+	}
+
+	sign := ""
+	switch {
+	case s.Flag('+'):
+		sign = "+"
+	case s.Flag(' '):
+		sign = " "
+	}
+
+	prefix := ""
+	if s.Flag('#') {
+		switch ch {
+		case 'b':
+			prefix = "0b"
+		case 'o':
+			prefix = "0"
+		case 'x':
+			prefix = "0x"
+		case 'X':
+			prefix = "0X"
+		}
+	}
+	if ch == 'O' {
+		prefix = "0o"
+	}
+
+	digits := this.Text(base)
+	if ch == 'X' {
+		digits = strings.ToUpper(digits)
+	}
+
+	// [left pad][sign][prefix][zero pad][digits][right pad]
+	var left, zeros, right int
+	precision, precisionSet := s.Precision()
+	if precisionSet {
+		switch {
+		case len(digits) < precision:
+			zeros = precision - len(digits)
+		case digits == "0" && precision == 0:
+			return
+		}
+	} else if this.Width() > 0 && base != 10 {
+		if widthDigits := widthInDigits(this.Width(), base); len(digits) < widthDigits {
+			zeros = widthDigits - len(digits)
+		}
+	}
+
+	length := len(sign) + len(prefix) + zeros + len(digits)
+	if width, widthSet := s.Width(); widthSet && length < width {
+		switch pad := width - length; {
+		case s.Flag('-'):
+			right = pad
+		case s.Flag('0') && !precisionSet:
+			zeros += pad
+		default:
+			left = pad
+		}
+	}
+
+	fmt.Fprint(s, strings.Repeat(" ", left), sign, prefix, strings.Repeat("0", zeros), digits, strings.Repeat(" ", right))
+}
+
+// widthInDigits returns the number of digits in base 2, 8 or 16 needed to
+// print width bits.
+func widthInDigits(width uint, base int) int {
+	bitsPerDigit := uint(1)
+	switch base {
+	case 8:
+		bitsPerDigit = 3
+	case 16:
+		bitsPerDigit = 4
+	}
+	return int((width + bitsPerDigit - 1) / bitsPerDigit)
+}
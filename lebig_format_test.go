@@ -0,0 +1,88 @@
+package lebig_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	formats := []string{
+		"%d", "%x", "%X", "%b", "%o", "%O", "%s", "%v",
+		"%#x", "%#X", "%#b", "%#o", "%+d", "% d",
+		"%40d", "%-40x|", "%040x", "%#040x", "%.50d", "%60.50x", "%.0d",
+		"%q",
+	}
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(40)
+		if rand.Intn(10) == 0 {
+			randBytes = []byte{0}
+		}
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+		aBigInt := bigFromBytes(randBytes)
+
+		if anInt.String() != aBigInt.String() {
+			t.Error("String not Equal on repetition: ", x)
+			t.Error(anInt.String())
+			t.Error(aBigInt.String())
+			t.FailNow()
+		}
+		if out := fmt.Sprint(anInt); out != aBigInt.String() {
+			t.Error("Int value not printed on repetition: ", x, out)
+			t.FailNow()
+		}
+		for base := 2; base <= 36; base++ {
+			if anInt.Text(base) != aBigInt.Text(base) {
+				t.Error("Text not Equal on repetition: ", x, base)
+				t.Error(anInt.Text(base))
+				t.Error(aBigInt.Text(base))
+				t.FailNow()
+			}
+		}
+		for _, format := range formats {
+			out := fmt.Sprintf(format, &anInt)
+			expected := fmt.Sprintf(format, aBigInt)
+			if format == "%q" {
+				expected = "%!q(lebig.Int=" + aBigInt.String() + ")"
+			}
+			if out != expected {
+				t.Error(format, " not Equal on repetition: ", x)
+				t.Error(out)
+				t.Error(expected)
+				t.FailNow()
+			}
+		}
+	}
+}
+
+func TestFormatWidth(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.NewWidth(13)
+	anInt.SetUint64(0x2A)
+	cases := map[string]string{
+		"%x":    "002a",
+		"%#X":   "0X002A",
+		"%b":    "0000000101010",
+		"%o":    "00052",
+		"%d":    "42",
+		"%8x":   "    002a",
+		"%08x":  "0000002a",
+		"%.2x":  "2a",
+		"%-6x|": "002a  |",
+	}
+	for format, expected := range cases {
+		if out := fmt.Sprintf(format, anInt); out != expected {
+			t.Errorf("%s: got %q, expected %q", format, out, expected)
+		}
+	}
+	if anInt.String() != "42" {
+		t.Error("wrong String", anInt.String())
+	}
+}
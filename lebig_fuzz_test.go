@@ -0,0 +1,190 @@
+package lebig_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+// The seed corpus of every target also lives in testdata/fuzz.
+
+// maxFuzzShift keeps shifted values small enough for the fuzzer to stay
+// fast.
+const maxFuzzShift = 4096
+
+// maxFuzzWidth bounds the widths tried by the arithmetic target.
+const maxFuzzWidth = 1024
+
+// checkBig fails t when anInt does not hold expected.
+func checkBig(t *testing.T, op string, backend lebig.Backend, expected *big.Int, anInt *lebig.Int) {
+	t.Helper()
+	got := bigFromBytes(anInt.Bytes())
+	if got.Cmp(expected) != 0 {
+		t.Fatalf("%s on %s: got %x, expected %x", op, backend.Name(), got, expected)
+	}
+}
+
+func newFuzzInt(backend lebig.Backend, width uint, in []byte) *lebig.Int {
+	anInt := lebig.NewWithBackend(backend)
+	anInt.SetWidth(width)
+	anInt.SetBytes(in)
+	return anInt
+}
+
+func FuzzSetBytes(f *testing.F) {
+	f.Add([]byte{0, 186, 66, 17, 232, 6, 170, 143, 86, 147})
+	f.Add([]byte{})
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 0, 0})
+	f.Add([]byte{1, 0, 0, 0, 0, 0, 0, 0, 0})
+	f.Fuzz(func(t *testing.T, in []byte) {
+		x := bigFromBytes(in)
+		expected := bytesFromBig(x)
+		for _, backend := range backends {
+			anInt := newFuzzInt(backend, 0, in)
+			if !bytes.Equal(expected, anInt.Bytes()) {
+				t.Fatalf("Bytes on %s: got %x, expected %x", backend.Name(), anInt.Bytes(), expected)
+			}
+			checkBig(t, "SetBytes", backend, x, anInt)
+			if anInt.BitLen() != uint(x.BitLen()) {
+				t.Fatalf("BitLen on %s: got %d, expected %d", backend.Name(), anInt.BitLen(), x.BitLen())
+			}
+		}
+	})
+}
+
+func FuzzShift(f *testing.F) {
+	f.Add([]byte{0, 186, 66, 17, 232, 6, 170, 143, 86, 147}, uint(3))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, uint(64))
+	f.Add([]byte{1}, uint(128))
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 0x80}, uint(63))
+	f.Fuzz(func(t *testing.T, in []byte, n uint) {
+		n %= maxFuzzShift
+		x := bigFromBytes(in)
+		for _, backend := range backends {
+			anInt := newFuzzInt(backend, 0, in)
+			anInt.ShiftLeft(n)
+			checkBig(t, "ShiftLeft", backend, new(big.Int).Lsh(x, n), anInt)
+
+			anInt = newFuzzInt(backend, 0, in)
+			anInt.ShiftRight(n)
+			checkBig(t, "ShiftRight", backend, new(big.Int).Rsh(x, n), anInt)
+
+			anInt = newFuzzInt(backend, 0, in)
+			anInt.SmallShiftLeft(n % 64)
+			checkBig(t, "SmallShiftLeft", backend, new(big.Int).Lsh(x, n%64), anInt)
+
+			anInt = newFuzzInt(backend, 0, in)
+			anInt.SmallShiftRight(n % 64)
+			checkBig(t, "SmallShiftRight", backend, new(big.Int).Rsh(x, n%64), anInt)
+		}
+	})
+}
+
+func FuzzBitwise(f *testing.F) {
+	f.Add([]byte{0, 186, 66, 17, 232, 6, 170, 143, 86, 147}, []byte{0xff})
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []byte{1, 2, 3})
+	f.Add([]byte{}, []byte{0, 0, 0, 0, 0, 0, 0, 0, 1})
+	f.Fuzz(func(t *testing.T, in, op []byte) {
+		x, y := bigFromBytes(in), bigFromBytes(op)
+		yUint64 := new(big.Int).SetUint64(y.Uint64())
+		for _, backend := range backends {
+			operand := newFuzzInt(backend, 0, op)
+			cases := []struct {
+				name     string
+				apply    func(anInt *lebig.Int)
+				expected *big.Int
+			}{
+				{"And", func(anInt *lebig.Int) { anInt.And(operand) }, new(big.Int).And(x, y)},
+				{"Or", func(anInt *lebig.Int) { anInt.Or(operand) }, new(big.Int).Or(x, y)},
+				{"Xor", func(anInt *lebig.Int) { anInt.Xor(operand) }, new(big.Int).Xor(x, y)},
+				{"AndNot", func(anInt *lebig.Int) { anInt.AndNot(operand) }, new(big.Int).AndNot(x, y)},
+				{"AndBytes", func(anInt *lebig.Int) { anInt.AndBytes(op) }, new(big.Int).And(x, y)},
+				{"OrBytes", func(anInt *lebig.Int) { anInt.OrBytes(op) }, new(big.Int).Or(x, y)},
+				{"XorBytes", func(anInt *lebig.Int) { anInt.XorBytes(op) }, new(big.Int).Xor(x, y)},
+				{"AndUint64", func(anInt *lebig.Int) { anInt.AndUint64(y.Uint64()) }, new(big.Int).And(x, yUint64)},
+				{"OrUint64", func(anInt *lebig.Int) { anInt.OrUint64(y.Uint64()) }, new(big.Int).Or(x, yUint64)},
+				{"XorUint64", func(anInt *lebig.Int) { anInt.XorUint64(y.Uint64()) }, new(big.Int).Xor(x, yUint64)},
+			}
+			for _, c := range cases {
+				anInt := newFuzzInt(backend, 0, in)
+				c.apply(anInt)
+				checkBig(t, c.name, backend, c.expected, anInt)
+			}
+		}
+	})
+}
+
+func FuzzArithmetic(f *testing.F) {
+	f.Add([]byte{0, 186, 66, 17, 232, 6, 170, 143, 86, 147}, []byte{3}, uint(0))
+	f.Add([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, []byte{1}, uint(64))
+	f.Add([]byte{0, 0, 0, 0, 0, 0, 0, 0, 1}, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, uint(0))
+	f.Add([]byte{1}, []byte{2}, uint(7))
+	f.Fuzz(func(t *testing.T, in, op []byte, width uint) {
+		width %= maxFuzzWidth
+		x, y := bigFromBytes(in), bigFromBytes(op)
+		if width > 0 {
+			x = truncateBig(x, width)
+		}
+		wrap := func(in *big.Int) *big.Int {
+			if width == 0 {
+				return in
+			}
+			return truncateBig(in, width)
+		}
+		for _, backend := range backends {
+			operand := newFuzzInt(backend, 0, op)
+
+			anInt := newFuzzInt(backend, width, in)
+			anInt.Add(operand)
+			checkBig(t, "Add", backend, wrap(new(big.Int).Add(x, y)), anInt)
+
+			anInt = newFuzzInt(backend, width, in)
+			anInt.AddBytes(op)
+			checkBig(t, "AddBytes", backend, wrap(new(big.Int).Add(x, y)), anInt)
+
+			anInt = newFuzzInt(backend, width, in)
+			anInt.Mul(operand)
+			checkBig(t, "Mul", backend, wrap(new(big.Int).Mul(x, y)), anInt)
+
+			anInt = newFuzzInt(backend, width, in)
+			anInt.MulBytes(op)
+			checkBig(t, "MulBytes", backend, wrap(new(big.Int).Mul(x, y)), anInt)
+
+			if width > 0 || x.Cmp(y) >= 0 {
+				anInt = newFuzzInt(backend, width, in)
+				anInt.Sub(operand)
+				checkBig(t, "Sub", backend, wrap(new(big.Int).Sub(x, y)), anInt)
+			} else if !panics(func() { newFuzzInt(backend, width, in).Sub(operand) }) {
+				t.Fatalf("Sub on %s: expected an underflow panic", backend.Name())
+			}
+
+			if y.Sign() == 0 {
+				if !panics(func() { newFuzzInt(backend, width, in).Div(operand) }) {
+					t.Fatalf("Div on %s: expected a division by zero panic", backend.Name())
+				}
+				continue
+			}
+			q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+			anInt = newFuzzInt(backend, width, in)
+			mod := lebig.NewWithBackend(backend)
+			anInt.DivMod(operand, mod)
+			checkBig(t, "DivMod quotient", backend, q, anInt)
+			checkBig(t, "DivMod remainder", backend, r, mod)
+
+			anInt = newFuzzInt(backend, width, in)
+			if anInt.Cmp(operand) != x.Cmp(y) {
+				t.Fatalf("Cmp on %s: got %d, expected %d", backend.Name(), anInt.Cmp(operand), x.Cmp(y))
+			}
+		}
+	})
+}
+
+func panics(f func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	f()
+	return false
+}
@@ -0,0 +1,390 @@
+package lebig
+
+import "math/big"
+
+// Int is an unsigned little endian integer. The zero value is an unbounded
+// zero that takes the default Backend on its first write.
+type Int struct {
+	backend Backend
+}
+
+// get returns the backend of this for reading, a zero of the default
+// Backend when this has none yet.
+func (this *Int) get() Backend {
+	if this.backend == nil {
+		return DefaultBackend().New()
+	}
+	return this.backend
+}
+
+// set returns the backend of this for writing, creating it if needed.
+func (this *Int) set() Backend {
+	if this.backend == nil {
+		this.backend = DefaultBackend().New()
+	}
+	return this.backend
+}
+
+// setWords sets this to words like SetWords, but a words backend keeps the
+// slice instead of copying it.
+func (this *Int) setWords(words []uint64) {
+	if backend, ok := this.set().(*wordsInt); ok {
+		backend.setWords(words)
+		return
+	}
+	this.set().SetWords(words)
+}
+
+// NewWidth returns a zero valued Int that wraps around at n bits, every
+// operation on it is truncated to n bits and Bytes returns exactly
+// ceil(n/8) bytes. A width of 0 means the Int is unbounded.
+func NewWidth(n uint) *Int {
+	anInt := NewWithBackend(DefaultBackend())
+	anInt.SetWidth(n)
+	return anInt
+}
+
+// NewWithBackend returns a zero valued, unbounded Int that uses a Backend
+// of the same kind as b.
+func NewWithBackend(b Backend) *Int {
+	if b == nil {
+		panic(errNilBackend)
+	}
+	return &Int{backend: b.New()}
+}
+
+// Backend returns a zero valued Backend of the kind used by this.
+func (this *Int) Backend() Backend {
+	return this.get().New()
+}
+
+// SetBackend moves the value and width of this to a Backend of the same
+// kind as b.
+func (this *Int) SetBackend(b Backend) {
+	if b == nil {
+		panic(errNilBackend)
+	}
+	old := this.get()
+	backend := b.New()
+	backend.SetWidth(old.Width())
+	backend.SetWords(old.Words())
+	this.backend = backend
+}
+
+func (this *Int) Width() uint {
+	return this.get().Width()
+}
+
+// SetWidth changes the width of this, truncating the current value when it
+// does not fit in n bits.
+func (this *Int) SetWidth(n uint) {
+	this.set().SetWidth(n)
+}
+
+// Words returns a copy of the 64 bit words of this, least significant word
+// first, without most significant zero words.
+func (this *Int) Words() []uint64 {
+	return this.get().Words()
+}
+
+// SetWords sets this to the 64 bit words in, least significant word first.
+func (this *Int) SetWords(in []uint64) {
+	this.set().SetWords(in)
+}
+
+func (this *Int) BitLen() uint {
+	return this.get().BitLen()
+}
+
+func (this *Int) ByteLen() uint {
+	return this.get().ByteLen()
+}
+
+func (this *Int) WordLen() uint {
+	return this.get().WordLen()
+}
+
+// TrailingZeros returns the number of consecutive least significant zero
+// bits of this, 0 when this is zero.
+func (this *Int) TrailingZeros() uint {
+	return this.get().TrailingZeros()
+}
+
+func (this *Int) SetBytes(in []byte) {
+	this.set().SetBytes(in)
+}
+
+func (this *Int) SetUint64(in uint64) {
+	this.set().SetUint64(in)
+}
+
+func (this *Int) Uint64() uint64 {
+	return this.get().Uint64()
+}
+
+func (this *Int) Bytes() []byte {
+	return this.get().Bytes()
+}
+
+// AppendBytes appends the bytes returned by Bytes to dst and returns the
+// extended slice, it only allocates when dst has no room.
+func (this *Int) AppendBytes(dst []byte) []byte {
+	return this.get().AppendBytes(dst)
+}
+
+// PutBytes writes the bytes returned by Bytes to the start of dst and
+// returns their number. It panics if dst is too short.
+func (this *Int) PutBytes(dst []byte) int {
+	return this.get().PutBytes(dst)
+}
+
+// SetBig sets this to x. It panics if x is negative.
+func (this *Int) SetBig(x *big.Int) {
+	this.set().SetBig(x)
+}
+
+// Big returns a new big.Int set to this.
+func (this *Int) Big() *big.Int {
+	return this.get().Big()
+}
+
+// Text returns the digits of this in base, which must be between 2 and 36.
+func (this *Int) Text(base int) string {
+	return this.get().Text(base)
+}
+
+func (this *Int) SmallShiftRight(sr uint) {
+	this.set().SmallShiftRight(sr)
+}
+
+func (this *Int) SmallShiftLeft(sl uint) {
+	this.set().SmallShiftLeft(sl)
+}
+
+func (this *Int) ShiftLeft(sl uint) {
+	this.set().ShiftLeft(sl)
+}
+
+func (this *Int) ShiftRight(sl uint) {
+	this.set().ShiftRight(sl)
+}
+
+// ArithShiftRight treats this as a two's complement value of its width and
+// shifts it right by sr, filling the vacated bits with the sign bit. It
+// panics on an unbounded Int.
+func (this *Int) ArithShiftRight(sr uint) {
+	this.set().ArithShiftRight(sr)
+}
+
+// SignExtend reads the low fromBits of this as a two's complement value and
+// sets this to it sign extended to toBits, which becomes the width. It
+// panics unless 0 < fromBits <= toBits.
+func (this *Int) SignExtend(fromBits, toBits uint) {
+	this.set().SignExtend(fromBits, toBits)
+}
+
+// Truncate keeps the low bits of this and makes bits the width, which in
+// two's complement wraps the value around. It panics if bits is 0 or wider
+// than the width of a fixed width Int.
+func (this *Int) Truncate(bits uint) {
+	this.set().Truncate(bits)
+}
+
+func (this *Int) AndUint64(in uint64) {
+	this.set().AndUint64(in)
+}
+
+func (this *Int) AndBytes(in []byte) {
+	this.set().AndBytes(in)
+}
+
+func (this *Int) OrUint64(in uint64) {
+	this.set().OrUint64(in)
+}
+
+func (this *Int) OrBytes(in []byte) {
+	this.set().OrBytes(in)
+}
+
+func (this *Int) And(in *Int) {
+	this.set().And(in.get())
+}
+
+func (this *Int) Or(in *Int) {
+	this.set().Or(in.get())
+}
+
+func (this *Int) Xor(in *Int) {
+	this.set().Xor(in.get())
+}
+
+func (this *Int) XorUint64(in uint64) {
+	this.set().XorUint64(in)
+}
+
+func (this *Int) XorBytes(in []byte) {
+	this.set().XorBytes(in)
+}
+
+// AndNot clears the bits of this that are set in in.
+func (this *Int) AndNot(in *Int) {
+	this.set().AndNot(in.get())
+}
+
+// Not complements the low width bits of this and clears the bits above
+// them. A width of 0 uses the width of this, Not panics when both are 0.
+func (this *Int) Not(width uint) {
+	this.set().Not(width)
+}
+
+// RotateLeft rotates the low width bits of this left by n and clears the
+// bits above them. A width of 0 uses the width of this, RotateLeft panics
+// when both are 0.
+func (this *Int) RotateLeft(n, width uint) {
+	this.set().RotateLeft(n, width)
+}
+
+// RotateRight rotates the low width bits of this right by n and clears the
+// bits above them. A width of 0 uses the width of this, RotateRight panics
+// when both are 0.
+func (this *Int) RotateRight(n, width uint) {
+	this.set().RotateRight(n, width)
+}
+
+func (this *Int) Add(in *Int) {
+	this.set().Add(in.get())
+}
+
+func (this *Int) AddUint64(in uint64) {
+	this.set().AddUint64(in)
+}
+
+func (this *Int) AddBytes(in []byte) {
+	this.set().AddBytes(in)
+}
+
+// Sub sets this to this - in. On an unbounded Int it panics when in is
+// greater than this, a fixed width Int wraps around instead.
+func (this *Int) Sub(in *Int) {
+	this.set().Sub(in.get())
+}
+
+func (this *Int) SubUint64(in uint64) {
+	this.set().SubUint64(in)
+}
+
+func (this *Int) SubBytes(in []byte) {
+	this.set().SubBytes(in)
+}
+
+func (this *Int) Mul(in *Int) {
+	this.set().Mul(in.get())
+}
+
+func (this *Int) MulUint64(in uint64) {
+	this.set().MulUint64(in)
+}
+
+func (this *Int) MulBytes(in []byte) {
+	this.set().MulBytes(in)
+}
+
+// Div sets this to the quotient this / in. It panics if in is zero.
+func (this *Int) Div(in *Int) {
+	this.set().Div(in.get())
+}
+
+// Mod sets this to the remainder this % in. It panics if in is zero.
+func (this *Int) Mod(in *Int) {
+	this.set().Mod(in.get())
+}
+
+// DivMod sets this to the quotient this / in and mod to the remainder
+// this % in. It panics if in is zero.
+func (this *Int) DivMod(in *Int, mod *Int) {
+	this.set().DivMod(in.get(), mod.set())
+}
+
+// DivUint64 sets this to the quotient this / in and returns the remainder.
+// It panics if in is zero.
+func (this *Int) DivUint64(in uint64) uint64 {
+	return this.set().DivUint64(in)
+}
+
+// ModUint64 returns the remainder this % in without modifying this.
+// It panics if in is zero.
+func (this *Int) ModUint64(in uint64) uint64 {
+	return this.get().ModUint64(in)
+}
+
+func (this *Int) Bit(i uint) uint {
+	return this.get().Bit(i)
+}
+
+func (this *Int) TestBit(i uint) bool {
+	return this.Bit(i) == 1
+}
+
+// SetBit sets bit i of this to v, growing this when i is beyond its current
+// size. It panics if v is not 0 or 1.
+func (this *Int) SetBit(i uint, v uint) {
+	this.set().SetBit(i, v)
+}
+
+func (this *Int) ClearBit(i uint) {
+	this.set().ClearBit(i)
+}
+
+func (this *Int) FlipBit(i uint) {
+	this.SetBit(i, this.Bit(i)^1)
+}
+
+// Field returns bits [hi:lo] of this as an Int of width hi-lo+1.
+func (this *Int) Field(hi, lo uint) *Int {
+	return &Int{backend: this.get().Field(hi, lo)}
+}
+
+// FieldUint64 returns bits [hi:lo] of this. It panics if the field is wider
+// than 64 bits.
+func (this *Int) FieldUint64(hi, lo uint) uint64 {
+	return this.get().FieldUint64(hi, lo)
+}
+
+// SetField overwrites bits [hi:lo] of this with the low hi-lo+1 bits of v.
+func (this *Int) SetField(hi, lo uint, v *Int) {
+	this.set().SetField(hi, lo, v.get())
+}
+
+func (this *Int) SetFieldUint64(hi, lo uint, v uint64) {
+	this.set().SetFieldUint64(hi, lo, v)
+}
+
+// Cmp compares this and in and returns -1 if this < in, 0 if this == in
+// and +1 if this > in.
+func (this *Int) Cmp(in *Int) int {
+	return this.get().Cmp(in.get())
+}
+
+func (this *Int) CmpUint64(in uint64) int {
+	return this.get().CmpUint64(in)
+}
+
+func (this *Int) Equal(in *Int) bool {
+	return this.Cmp(in) == 0
+}
+
+func (this *Int) Less(in *Int) bool {
+	return this.Cmp(in) < 0
+}
+
+func (this *Int) IsZero() bool {
+	return this.get().IsZero()
+}
+
+// Sign returns 0 if this is zero and +1 otherwise.
+func (this *Int) Sign() int {
+	if this.IsZero() {
+		return 0
+	}
+	return 1
+}
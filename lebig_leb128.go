@@ -0,0 +1,169 @@
+package lebig
+
+import (
+	"io"
+)
+
+// AppendULEB128 appends the unsigned LEB128 encoding of this to dst.
+func (this *Int) AppendULEB128(dst []byte) []byte {
+	words := this.Words()
+	return appendLEB128(dst, words, groupsForBits(bitLenWords(words)), 0)
+}
+
+// AppendSLEB128 appends the signed LEB128 encoding of this to dst. A fixed
+// width Int is taken as a two's complement value, negative when its top bit
+// is set, an unbounded Int is never negative.
+func (this *Int) AppendSLEB128(dst []byte) []byte {
+	words := this.Words()
+	if this.Width() == 0 || this.Bit(this.Width()-1) == 0 {
+		return appendLEB128(dst, words, groupsForBits(bitLenWords(words)+1), 0)
+	}
+
+	// sign extend above the width and count the bits below the sign
+	words = padWords(words, int(sizeInWordsFromBits(this.Width())))
+	if partial := this.Width() % 64; partial != 0 {
+		words[len(words)-1] |= ^uint64(0) << partial
+	}
+	complement := make([]uint64, len(words))
+	for i := range words {
+		complement[i] = ^words[i]
+	}
+	return appendLEB128(dst, words, groupsForBits(bitLenWords(complement)+1), ^uint64(0))
+}
+
+// ReadULEB128 sets this to the unsigned LEB128 value read from r. A maxBits
+// greater than 0, or the width of this if it is smaller, limits the value
+// and the number of groups read; exceeding it returns ErrOverflow. An r that
+// ends before the first byte returns io.EOF and one that ends inside the
+// value io.ErrUnexpectedEOF. On error this is left unchanged.
+func (this *Int) ReadULEB128(r io.ByteReader, maxBits uint) error {
+	limit := this.lebLimit(maxBits)
+	words, _, err := readLEB128(r, limit)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && bitLenWords(words) > limit {
+		return ErrOverflow
+	}
+	this.setWords(words)
+	return nil
+}
+
+// ReadSLEB128 sets this to the signed LEB128 value read from r with the
+// limits and errors of ReadULEB128, the value must fit in limit bits as two's
+// complement. A negative value is stored as its two's complement in the
+// width of this and returns ErrNegative when this is unbounded.
+func (this *Int) ReadSLEB128(r io.ByteReader, maxBits uint) error {
+	limit := this.lebLimit(maxBits)
+	words, groups, err := readLEB128(r, limit)
+	if err != nil {
+		return err
+	}
+
+	encodedBits := uint(7 * groups)
+	negative := (words[(encodedBits-1)/64]>>((encodedBits-1)%64))&1 == 1
+	if !negative {
+		if limit > 0 && bitLenWords(words)+1 > limit {
+			return ErrOverflow
+		}
+		this.setWords(words)
+		return nil
+	}
+
+	if this.Width() == 0 {
+		return ErrNegative
+	}
+	complement := make([]uint64, sizeInWordsFromBits(encodedBits))
+	for i := range complement {
+		complement[i] = ^words[i]
+	}
+	if partial := encodedBits % 64; partial != 0 {
+		complement[len(complement)-1] &= 1<<partial - 1
+	}
+	if bitLenWords(complement)+1 > limit {
+		return ErrOverflow
+	}
+
+	// sign extend up to the width
+	words = padWords(words, int(sizeInWordsFromBits(this.Width())))
+	setOnesWords(words, encodedBits, this.Width())
+	this.setWords(words)
+	return nil
+}
+
+func (this *Int) lebLimit(maxBits uint) uint {
+	if this.Width() > 0 && (maxBits == 0 || this.Width() < maxBits) {
+		return this.Width()
+	}
+	return maxBits
+}
+
+// groupsForBits returns the number of 7 bit groups needed to hold n bits,
+// at least one.
+func groupsForBits(n uint) int {
+	if n == 0 {
+		return 1
+	}
+	return int((n + 6) / 7)
+}
+
+// appendLEB128 appends the low groups 7 bit groups of x, reading fill for
+// the bits above x.
+func appendLEB128(dst []byte, x []uint64, groups int, fill uint64) []byte {
+	word := func(i uint) uint64 {
+		if i < uint(len(x)) {
+			return x[i]
+		}
+		return fill
+	}
+	for i := 0; i < groups; i++ {
+		pos := uint(7 * i)
+		group := word(pos/64) >> (pos % 64)
+		if pos%64 > 57 {
+			group |= word(pos/64+1) << (64 - pos%64)
+		}
+		group &= 0x7f
+		if i < groups-1 {
+			group |= 0x80
+		}
+		dst = append(dst, byte(group))
+	}
+	return dst
+}
+
+// readLEB128 reads 7 bit groups from r straight into words until a byte
+// without the continuation bit, reading no more groups than needed for
+// limit bits when limit is greater than 0.
+func readLEB128(r io.ByteReader, limit uint) (words []uint64, groups int, err error) {
+	maxGroups := 0
+	if limit > 0 {
+		maxGroups = groupsForBits(limit)
+	}
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			if err == io.EOF && groups > 0 {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, 0, err
+		}
+		if maxGroups > 0 && groups == maxGroups {
+			return nil, 0, ErrOverflow
+		}
+
+		pos := uint(7 * groups)
+		for uint(len(words)) <= pos/64+1 {
+			words = append(words, 0)
+		}
+		group := uint64(b & 0x7f)
+		words[pos/64] |= group << (pos % 64)
+		if pos%64 > 57 {
+			words[pos/64+1] |= group >> (64 - pos%64)
+		}
+		groups++
+
+		if b&0x80 == 0 {
+			return words, groups, nil
+		}
+	}
+}
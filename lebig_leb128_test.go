@@ -0,0 +1,193 @@
+package lebig_test
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestLEB128Vectors(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	unsigned := []struct {
+		value    uint64
+		expected []byte
+	}{
+		{0, []byte{0x00}},
+		{2, []byte{0x02}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{624485, []byte{0xe5, 0x8e, 0x26}},
+		{^uint64(0), []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+	}
+	for _, c := range unsigned {
+		anInt := lebig.Int{}
+		anInt.SetUint64(c.value)
+		if out := anInt.AppendULEB128(nil); !reflect.DeepEqual(out, c.expected) {
+			t.Errorf("ULEB128 %d: got %x, expected %x", c.value, out, c.expected)
+		}
+	}
+
+	signed := []struct {
+		value    int64
+		expected []byte
+	}{
+		{0, []byte{0x00}},
+		{2, []byte{0x02}},
+		{-1, []byte{0x7f}},
+		{63, []byte{0x3f}},
+		{64, []byte{0xc0, 0x00}},
+		{127, []byte{0xff, 0x00}},
+		{-64, []byte{0x40}},
+		{-128, []byte{0x80, 0x7f}},
+		{-123456, []byte{0xc0, 0xbb, 0x78}},
+	}
+	for _, c := range signed {
+		anInt := lebig.NewWidth(32)
+		anInt.SetUint64(uint64(c.value))
+		out := anInt.AppendSLEB128(nil)
+		if !reflect.DeepEqual(out, c.expected) {
+			t.Errorf("SLEB128 %d: got %x, expected %x", c.value, out, c.expected)
+		}
+
+		outInt := lebig.NewWidth(32)
+		if err := outInt.ReadSLEB128(bytes.NewReader(out), 0); err != nil || !outInt.Equal(anInt) {
+			t.Errorf("SLEB128 %d: decoded %s, %v", c.value, outInt, err)
+		}
+	}
+}
+
+func TestULEB128(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(200)
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+
+		out := anInt.AppendULEB128([]byte{0xAA})
+		expected := append([]byte{0xAA}, appendBigLEB128(nil, bigFromBytes(randBytes), false)...)
+		if !reflect.DeepEqual(out, expected) {
+			t.Error("not Equal on repetition: ", x)
+			t.Error(out)
+			t.Error(expected)
+			t.FailNow()
+		}
+
+		outInt := lebig.Int{}
+		reader := bytes.NewReader(append(out[1:], 0x55))
+		if err := outInt.ReadULEB128(reader, 0); err != nil {
+			t.Fatal(err)
+		}
+		if !outInt.Equal(&anInt) || reader.Len() != 1 {
+			t.Error("decoded not Equal on repetition: ", x, reader.Len())
+			t.FailNow()
+		}
+	}
+}
+
+func TestSLEB128(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(100)
+		width := uint(rand.Intn(len(randBytes)*8) + 1)
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randBytes)
+
+		// the two's complement value of anInt
+		aBigInt := bigFromBytes(anInt.Bytes())
+		if aBigInt.Bit(int(width-1)) == 1 {
+			aBigInt.Sub(aBigInt, new(big.Int).Lsh(big.NewInt(1), width))
+		}
+
+		out := anInt.AppendSLEB128(nil)
+		expected := appendBigLEB128(nil, aBigInt, true)
+		if !reflect.DeepEqual(out, expected) {
+			t.Error("not Equal on repetition: ", x, width, aBigInt)
+			t.Error(out)
+			t.Error(expected)
+			t.FailNow()
+		}
+
+		outInt := lebig.NewWidth(width)
+		if err := outInt.ReadSLEB128(bytes.NewReader(out), 0); err != nil {
+			t.Fatal(x, err)
+		}
+		if !outInt.Equal(anInt) {
+			t.Error("decoded not Equal on repetition: ", x, width)
+			t.FailNow()
+		}
+
+		// a wider Int sign extends the value
+		wideInt := lebig.NewWidth(width + 70)
+		if err := wideInt.ReadSLEB128(bytes.NewReader(out), 0); err != nil {
+			t.Fatal(x, err)
+		}
+		if !reflect.DeepEqual(wideInt.AppendSLEB128(nil), out) {
+			t.Error("sign extension not Equal on repetition: ", x, width)
+			t.FailNow()
+		}
+	}
+}
+
+func TestLEB128Errors(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.Int{}
+	anInt.SetUint64(42)
+
+	if err := anInt.ReadULEB128(bytes.NewReader(nil), 0); err != io.EOF {
+		t.Error("expected io.EOF, got", err)
+	}
+	if err := anInt.ReadULEB128(bytes.NewReader([]byte{0x80, 0x80}), 0); err != io.ErrUnexpectedEOF {
+		t.Error("expected io.ErrUnexpectedEOF, got", err)
+	}
+	if err := anInt.ReadULEB128(bytes.NewReader([]byte{0x80, 0x02}), 8); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow on value, got", err)
+	}
+	if err := anInt.ReadULEB128(bytes.NewReader([]byte{0x80, 0x80, 0x00}), 8); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow on groups, got", err)
+	}
+	if err := lebig.NewWidth(8).ReadULEB128(bytes.NewReader([]byte{0x80, 0x02}), 0); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow on width, got", err)
+	}
+	if err := anInt.ReadSLEB128(bytes.NewReader([]byte{0x7f}), 0); err != lebig.ErrNegative {
+		t.Error("expected ErrNegative, got", err)
+	}
+	if err := anInt.ReadSLEB128(bytes.NewReader([]byte{0xff, 0x00}), 7); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow on positive signed value, got", err)
+	}
+	if err := lebig.NewWidth(8).ReadSLEB128(bytes.NewReader([]byte{0xff, 0x7e}), 0); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow on negative signed value, got", err)
+	}
+	if anInt.Uint64() != 42 {
+		t.Error("value changed on error", anInt)
+	}
+
+	if err := anInt.ReadULEB128(bytes.NewReader([]byte{0x80, 0x80, 0x00}), 0); err != nil || !anInt.IsZero() {
+		t.Error("padded zero not accepted", err, anInt)
+	}
+}
+
+// appendBigLEB128 is the reference LEB128 encoder, signed leaves room for
+// the sign bit in the last group.
+func appendBigLEB128(dst []byte, in *big.Int, signed bool) []byte {
+	value := new(big.Int).Set(in)
+	for {
+		group := byte(new(big.Int).And(value, big.NewInt(0x7f)).Uint64())
+		value.Rsh(value, 7)
+		signBit := group&0x40 != 0
+		switch {
+		case value.Sign() == 0 && (!signed || !signBit),
+			value.Cmp(big.NewInt(-1)) == 0 && signBit:
+			return append(dst, group)
+		}
+		dst = append(dst, group|0x80)
+	}
+}
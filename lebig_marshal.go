@@ -0,0 +1,110 @@
+package lebig
+
+import (
+	"fmt"
+)
+
+// JSONEncoding selects how MarshalJSONFormat writes an Int.
+type JSONEncoding int
+
+const (
+	// JSONHex writes a string with a 0x prefixed hexadecimal value, zero
+	// padded to the width of fixed width values. It is what MarshalJSON
+	// uses.
+	JSONHex JSONEncoding = iota
+
+	// JSONDecimal writes a string with the decimal value.
+	JSONDecimal
+
+	// JSONNumber writes values up to 2^53-1 as raw JSON numbers, which
+	// every JSON decoder reads without losing precision, and larger values
+	// as decimal strings.
+	JSONNumber
+)
+
+const maxJSONNumber = 1<<53 - 1
+
+// MarshalText implements encoding.TextMarshaler, writing this as a 0x
+// prefixed hexadecimal value.
+func (this *Int) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%#x", this)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler accepting any value
+// Parse accepts. A fixed width Int keeps its width and returns ErrOverflow
+// for values that do not fit.
+func (this *Int) UnmarshalText(text []byte) error {
+	parsed, err := parseString(string(text), 0)
+	if err != nil {
+		return err
+	}
+	out := parsed.Bytes()
+	if this.Width() > 0 && bytesOverflow(out, this.Width()) {
+		return ErrOverflow
+	}
+	this.SetBytes(out)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler with the JSONHex encoding, JSONInt
+// selects another one.
+func (this *Int) MarshalJSON() ([]byte, error) {
+	return this.MarshalJSONFormat(JSONHex)
+}
+
+// MarshalJSONFormat returns the JSON encoding of this in format.
+func (this *Int) MarshalJSONFormat(format JSONEncoding) ([]byte, error) {
+	switch format {
+	case JSONDecimal:
+		return []byte(`"` + this.String() + `"`), nil
+	case JSONNumber:
+		if this.CmpUint64(maxJSONNumber) <= 0 {
+			return []byte(this.String()), nil
+		}
+		return []byte(`"` + this.String() + `"`), nil
+	default:
+		text, err := this.MarshalText()
+		return []byte(`"` + string(text) + `"`), err
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler accepting numbers and strings
+// in any of the JSONEncoding encodings. A JSON null leaves this unchanged.
+func (this *Int) UnmarshalJSON(text []byte) error {
+	if string(text) == "null" {
+		return nil
+	}
+	if len(text) >= 2 && text[0] == '"' && text[len(text)-1] == '"' {
+		text = text[1 : len(text)-1]
+	}
+	return this.UnmarshalText(text)
+}
+
+// JSONInt wraps an Int to choose its JSON encoding per value, typically as
+// a struct field:
+//
+//	Value lebig.JSONInt `json:"value"`
+//	...
+//	reg.Value = lebig.JSONInt{Int: anInt, Encoding: lebig.JSONDecimal}
+type JSONInt struct {
+	*Int
+	Encoding JSONEncoding
+}
+
+// MarshalJSON implements json.Marshaler with the Encoding of this, a nil
+// Int is written as null.
+func (this JSONInt) MarshalJSON() ([]byte, error) {
+	if this.Int == nil {
+		return []byte("null"), nil
+	}
+	return this.Int.MarshalJSONFormat(this.Encoding)
+}
+
+// UnmarshalJSON implements json.Unmarshaler accepting any encoding, it
+// allocates the Int when this has none.
+func (this *JSONInt) UnmarshalJSON(text []byte) error {
+	if this.Int == nil {
+		this.Int = &Int{}
+	}
+	return this.Int.UnmarshalJSON(text)
+}
@@ -0,0 +1,122 @@
+package lebig_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestMarshalText(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(60)
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+
+		text, err := anInt.MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(text) != "0x"+bigFromBytes(randBytes).Text(16) {
+			t.Error("wrong text on repetition: ", x, string(text))
+			t.FailNow()
+		}
+
+		outInt := lebig.Int{}
+		if err := outInt.UnmarshalText(text); err != nil {
+			t.Fatal(err)
+		}
+		if !outInt.Equal(&anInt) {
+			t.Error("not Equal on repetition: ", x, outInt.String(), anInt.String())
+			t.FailNow()
+		}
+	}
+
+	fixedInt := lebig.NewWidth(16)
+	if err := fixedInt.UnmarshalText([]byte("0x1_0000")); err != lebig.ErrOverflow {
+		t.Error("expected ErrOverflow, got", err)
+	}
+	if err := fixedInt.UnmarshalText([]byte("0x12g")); err == nil {
+		t.Error("expected a syntax error")
+	}
+	if err := fixedInt.UnmarshalText([]byte("42")); err != nil || fixedInt.Uint64() != 42 || fixedInt.Width() != 16 {
+		t.Error("decimal text not accepted", err, fixedInt)
+	}
+	if text, _ := fixedInt.MarshalText(); string(text) != "0x002a" {
+		t.Error("wrong fixed width text", string(text))
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+
+	type register struct {
+		Name  string
+		Value lebig.JSONInt
+	}
+	small := lebig.Int{}
+	small.SetUint64(1<<53 - 1)
+	large := lebig.Int{}
+	large.SetUint64(1 << 53)
+
+	cases := []struct {
+		format   lebig.JSONEncoding
+		value    *lebig.Int
+		expected string
+	}{
+		{lebig.JSONHex, &small, `{"Name":"r","Value":"0x1fffffffffffff"}`},
+		{lebig.JSONDecimal, &small, `{"Name":"r","Value":"9007199254740991"}`},
+		{lebig.JSONNumber, &small, `{"Name":"r","Value":9007199254740991}`},
+		{lebig.JSONNumber, &large, `{"Name":"r","Value":"9007199254740992"}`},
+		{lebig.JSONHex, nil, `{"Name":"r","Value":null}`},
+	}
+	for _, c := range cases {
+		value := lebig.JSONInt{Int: c.value, Encoding: c.format}
+		out, err := json.Marshal(register{Name: "r", Value: value})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(out) != c.expected {
+			t.Errorf("got %s, expected %s", out, c.expected)
+		}
+
+		decoded := register{}
+		if err := json.Unmarshal(out, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if c.value != nil && !decoded.Value.Equal(c.value) {
+			t.Errorf("%s decoded as %s", out, decoded.Value)
+		}
+	}
+
+	if out, _ := json.Marshal(&small); string(out) != `"0x1fffffffffffff"` {
+		t.Errorf("Int does not default to hex: %s", out)
+	}
+
+	for _, format := range []lebig.JSONEncoding{lebig.JSONHex, lebig.JSONDecimal, lebig.JSONNumber} {
+		for x := 0; x < globalRepeat; x++ {
+			anInt := lebig.Int{}
+			anInt.SetBytes(randomBytes(30))
+			out, err := anInt.MarshalJSONFormat(format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			outInt := lebig.Int{}
+			if err := json.Unmarshal(out, &outInt); err != nil {
+				t.Fatal(err)
+			}
+			if !outInt.Equal(&anInt) {
+				t.Error("not Equal on repetition: ", x, string(out), outInt.String())
+				t.FailNow()
+			}
+		}
+	}
+
+	invalid := lebig.Int{}
+	if err := json.Unmarshal([]byte(`"0xZZ"`), &invalid); err == nil {
+		t.Error("expected an error on invalid JSON value")
+	}
+}
@@ -0,0 +1,127 @@
+package lebig
+
+import (
+	"fmt"
+)
+
+// SyntaxError describes why and where a string could not be parsed.
+type SyntaxError struct {
+	Input  string
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("lebig: parsing %q: %s at offset %d", e.Input, e.Msg, e.Offset)
+}
+
+// Parse returns the value of s, a number in base 10 or, with a 0x, 0b, 0o
+// or 0 prefix, in base 16, 2 or 8. Underscores may separate digits and
+// follow the prefix as in Go number literals.
+func Parse(s string) (*Int, error) {
+	return parseString(s, 0)
+}
+
+// SetString sets this to the value of s in base and returns this and true,
+// or nil and false when s is not valid or does not fit in the width of this,
+// in which case this is left unchanged. A base of 0 accepts prefixes and
+// underscores like Parse, otherwise base must be between 2 and 36.
+func (this *Int) SetString(s string, base int) (*Int, bool) {
+	parsed, err := parseString(s, base)
+	if err != nil {
+		return nil, false
+	}
+	out := parsed.Bytes()
+	if this.Width() > 0 && bytesOverflow(out, this.Width()) {
+		return nil, false
+	}
+	this.SetBytes(out)
+	return this, true
+}
+
+func parseString(s string, base int) (*Int, error) {
+	syntaxError := func(offset int, format string, args ...interface{}) error {
+		return &SyntaxError{Input: s, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+	}
+	if base != 0 && (base < 2 || base > 36) {
+		return nil, syntaxError(0, "invalid base %d", base)
+	}
+
+	offset := 0
+	if len(s) > 0 && s[0] == '+' {
+		offset++
+	} else if len(s) > 0 && s[0] == '-' {
+		return nil, syntaxError(0, "negative value")
+	}
+
+	underscores := base == 0
+	prefixed := false
+	if base == 0 {
+		base = 10
+		if len(s) > offset+1 && s[offset] == '0' {
+			prefixed = true
+			switch s[offset+1] {
+			case 'x', 'X':
+				base = 16
+				offset += 2
+			case 'b', 'B':
+				base = 2
+				offset += 2
+			case 'o', 'O':
+				base = 8
+				offset += 2
+			default:
+				base = 8
+				offset++
+			}
+		}
+	}
+
+	anInt := &Int{}
+	chunk, chunkMul := uint64(0), uint64(1)
+	digits := 0
+	underscore := false
+	for i := offset; i < len(s); i++ {
+		c := s[i]
+		if c == '_' {
+			if !underscores || underscore || (digits == 0 && !prefixed) {
+				return nil, syntaxError(i, "unexpected underscore")
+			}
+			underscore = true
+			continue
+		}
+
+		digit := base
+		switch {
+		case '0' <= c && c <= '9':
+			digit = int(c - '0')
+		case 'a' <= c && c <= 'z':
+			digit = int(c-'a') + 10
+		case 'A' <= c && c <= 'Z':
+			digit = int(c-'A') + 10
+		}
+		if digit >= base {
+			return nil, syntaxError(i, "invalid digit %q for base %d", c, base)
+		}
+
+		if chunkMul > ^uint64(0)/uint64(base) {
+			anInt.MulUint64(chunkMul)
+			anInt.AddUint64(chunk)
+			chunk, chunkMul = 0, 1
+		}
+		chunk = chunk*uint64(base) + uint64(digit)
+		chunkMul *= uint64(base)
+		digits++
+		underscore = false
+	}
+
+	if underscore {
+		return nil, syntaxError(len(s)-1, "trailing underscore")
+	}
+	if digits == 0 {
+		return nil, syntaxError(len(s), "missing digits")
+	}
+	anInt.MulUint64(chunkMul)
+	anInt.AddUint64(chunk)
+	return anInt, nil
+}
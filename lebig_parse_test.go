@@ -0,0 +1,124 @@
+package lebig_test
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestSetString(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(60)
+		base := rand.Intn(35) + 2
+		aBigInt := bigFromBytes(randBytes)
+		text := aBigInt.Text(base)
+		if rand.Intn(2) == 0 {
+			text = strings.ToUpper(text)
+		}
+
+		anInt := lebig.Int{}
+		if _, ok := anInt.SetString(text, base); !ok {
+			t.Error("SetString failed on repetition: ", x, text, base)
+			t.FailNow()
+		}
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+	}
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	formats := []string{"%d", "%#x", "%#X", "%#b", "%O", "%#o"}
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(60)
+		aBigInt := bigFromBytes(randBytes)
+		text := fmt.Sprintf(formats[rand.Intn(len(formats))], aBigInt)
+
+		// sprinkle separators between digits after the base prefix
+		prefixLen := 0
+		if len(text) > 1 && strings.ContainsAny(text[1:2], "xXbBoO") {
+			prefixLen = 2
+		}
+		separated := []byte(text[:prefixLen])
+		for i := prefixLen; i < len(text); i++ {
+			separated = append(separated, text[i])
+			if i+1 < len(text) && rand.Intn(4) == 0 {
+				separated = append(separated, '_')
+			}
+		}
+
+		anInt, err := lebig.Parse(string(separated))
+		if err != nil {
+			t.Error("Parse failed on repetition: ", x, err)
+			t.FailNow()
+		}
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+
+		aBigParsed, ok := new(big.Int).SetString(string(separated), 0)
+		if !ok || aBigParsed.Cmp(aBigInt) != 0 {
+			t.Error("big.Int disagrees on repetition: ", x, string(separated))
+			t.FailNow()
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	cases := map[string]int{
+		"":        0,
+		"-1":      0,
+		"0x":      2,
+		"12a":     2,
+		"0b102":   4,
+		"0o8":     2,
+		"08":      1,
+		"_1":      0,
+		"1__2":    2,
+		"1_":      1,
+		"0x_":     2,
+		"0xFF_G0": 5,
+	}
+	for input, offset := range cases {
+		_, err := lebig.Parse(input)
+		syntaxError, ok := err.(*lebig.SyntaxError)
+		if !ok {
+			t.Errorf("%q: expected a SyntaxError, got %v", input, err)
+			continue
+		}
+		if syntaxError.Offset != offset || syntaxError.Input != input {
+			t.Errorf("%q: wrong offset %d, expected %d: %v", input, syntaxError.Offset, offset, err)
+		}
+		if _, ok := new(big.Int).SetString(input, 0); ok && input != "-1" {
+			t.Errorf("%q: big.Int accepts it", input)
+		}
+	}
+
+	valid := []string{"0", "+7", "0_7", "0x_1F", "0B1_0", "1_000_000"}
+	for _, input := range valid {
+		anInt, err := lebig.Parse(input)
+		if err != nil {
+			t.Errorf("%q: %v", input, err)
+			continue
+		}
+		aBigInt, _ := new(big.Int).SetString(input, 0)
+		if anInt.String() != aBigInt.String() {
+			t.Errorf("%q: got %s, expected %s", input, anInt, aBigInt)
+		}
+	}
+
+	if _, ok := (&lebig.Int{}).SetString("1_0", 10); ok {
+		t.Error("underscores accepted with an explicit base")
+	}
+	anInt := lebig.NewWidth(8)
+	anInt.SetUint64(3)
+	if _, ok := anInt.SetString("0x100", 0); ok || anInt.Uint64() != 3 {
+		t.Error("overflowing value accepted", anInt)
+	}
+}
@@ -1,7 +1,10 @@
 package lebig
 
 import (
+	"encoding/binary"
+	"math/big"
 	"math/bits"
+	"strconv"
 )
 
 //-------------------------------------------
@@ -13,14 +16,6 @@ func sizeInWordsFromBits(sizeInBits uint) uint {
 	return lenInWords
 }
 
-func sizeInWordsFromBytes(sizeInBytes uint) uint {
-	lenInWords := sizeInBytes / uint(8)
-	if sizeInBytes%8 != 0 {
-		lenInWords++
-	}
-	return lenInWords
-}
-
 func sizeInBytes(sizeInBits uint) uint {
 	lenInBytes := sizeInBits / 8
 	if sizeInBits%8 != 0 {
@@ -29,22 +24,6 @@ func sizeInBytes(sizeInBits uint) uint {
 	return lenInBytes
 }
 
-func recalcSizeInBytes(inWords []uint64) (outSizeInBytes uint) {
-	lastLenBits := bits.Len64(inWords[len(inWords)-1])
-	lastlenBytes := sizeInBytes(uint(lastLenBits))
-
-	if len(inWords) > 0 {
-		if lastLenBits > 0 {
-			outSizeInBytes = uint((len(inWords) * 8) - 8 + int(lastlenBytes))
-		} else {
-			outSizeInBytes = uint(len(inWords) * 8)
-		}
-	} else {
-		outSizeInBytes = 0
-	}
-	return outSizeInBytes
-}
-
 func RemoveMostSignificantZeroesFromBytes(in []byte) (out []byte) {
 
 	removeMostSignificantZeros := true
@@ -54,11 +33,12 @@ func RemoveMostSignificantZeroesFromBytes(in []byte) (out []byte) {
 			removeCounter++
 		} else {
 			removeMostSignificantZeros = false
+			break
 		}
 	}
 	out = in[0 : len(in)-removeCounter]
 
-	if len(out) == 0 {
+	if len(out) == 0 && len(in) != 0 {
 		return in[0:1]
 	}
 
@@ -73,9 +53,641 @@ func RemoveMostSignificantZeroesFromWords(in []uint64) (out []uint64) {
 			removeCounter++
 		} else {
 			removeMostSignificantZeros = false
+			break
 		}
 	}
 
 	out = in[0 : len(in)-removeCounter]
 	return out
 }
+
+const errSubUnderflow = "lebig: subtraction underflow"
+
+func addWords(x, y []uint64) []uint64 {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	out := make([]uint64, len(x)+1)
+	var carry uint64
+	for i := range x {
+		var yWord uint64
+		if i < len(y) {
+			yWord = y[i]
+		}
+		out[i], carry = bits.Add64(x[i], yWord, carry)
+	}
+	out[len(x)] = carry
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+// subWords returns x - y modulo 2^(64*max(len(x), len(y))) and a non zero
+// borrow when y > x.
+func subWords(x, y []uint64) (out []uint64, borrow uint64) {
+	size := len(x)
+	if len(y) > size {
+		size = len(y)
+	}
+	out = make([]uint64, size)
+	for i := range out {
+		var xWord, yWord uint64
+		if i < len(x) {
+			xWord = x[i]
+		}
+		if i < len(y) {
+			yWord = y[i]
+		}
+		out[i], borrow = bits.Sub64(xWord, yWord, borrow)
+	}
+	return RemoveMostSignificantZeroesFromWords(out), borrow
+}
+
+func mulWords(x, y []uint64) []uint64 {
+	if len(x) == 0 || len(y) == 0 {
+		return []uint64{}
+	}
+	out := make([]uint64, len(x)+len(y))
+	for i, xWord := range x {
+		if xWord == 0 {
+			continue
+		}
+		var carry uint64
+		for j, yWord := range y {
+			hi, lo := bits.Mul64(xWord, yWord)
+			var c uint64
+			lo, c = bits.Add64(lo, out[i+j], 0)
+			hi += c
+			lo, c = bits.Add64(lo, carry, 0)
+			hi += c
+			out[i+j] = lo
+			carry = hi
+		}
+		out[i+len(y)] = carry
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+const errDivisionByZero = "lebig: division by zero"
+
+func cmpWords(x, y []uint64) int {
+	x = RemoveMostSignificantZeroesFromWords(x)
+	y = RemoveMostSignificantZeroesFromWords(y)
+	if len(x) != len(y) {
+		if len(x) < len(y) {
+			return -1
+		}
+		return 1
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		if x[i] != y[i] {
+			if x[i] < y[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// shlWords returns x << s in a new slice of size words, s < 64.
+func shlWords(x []uint64, s uint, size int) []uint64 {
+	out := make([]uint64, size)
+	var carry uint64
+	for i := range x {
+		out[i] = x[i]<<s | carry
+		carry = x[i] >> (64 - s)
+	}
+	if len(x) < size {
+		out[len(x)] = carry
+	}
+	return out
+}
+
+// shrWords returns x >> s in a new slice, s < 64.
+func shrWords(x []uint64, s uint) []uint64 {
+	out := make([]uint64, len(x))
+	for i := range x {
+		out[i] = x[i] >> s
+		if i+1 < len(x) {
+			out[i] |= x[i+1] << (64 - s)
+		}
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+// lshWords returns x << s, reusing the backing array of x when it has room.
+// Words are written from the top down so an in place move never overwrites
+// a word that is still to be read.
+func lshWords(x []uint64, s uint) []uint64 {
+	x = RemoveMostSignificantZeroesFromWords(x)
+	if len(x) == 0 {
+		return x
+	}
+	wordShift, bitShift := int(s/64), s%64
+	size := len(x) + wordShift + 1
+	z := x[:cap(x)]
+	if len(z) < size {
+		z = make([]uint64, size)
+	}
+	z = z[:size]
+	top := len(x) - 1
+	z[top+wordShift+1] = x[top] >> (64 - bitShift)
+	for i := top; i > 0; i-- {
+		z[i+wordShift] = x[i]<<bitShift | x[i-1]>>(64-bitShift)
+	}
+	z[wordShift] = x[0] << bitShift
+	for i := 0; i < wordShift; i++ {
+		z[i] = 0
+	}
+	return RemoveMostSignificantZeroesFromWords(z)
+}
+
+// rshWords returns x >> s, computed in place from the bottom up.
+func rshWords(x []uint64, s uint) []uint64 {
+	wordShift, bitShift := int(s/64), s%64
+	if wordShift >= len(x) {
+		return x[:0]
+	}
+	size := len(x) - wordShift
+	for i := 0; i < size-1; i++ {
+		x[i] = x[i+wordShift]>>bitShift | x[i+wordShift+1]<<(64-bitShift)
+	}
+	x[size-1] = x[len(x)-1] >> bitShift
+	return RemoveMostSignificantZeroesFromWords(x[:size])
+}
+
+func divWordsUint64(x []uint64, y uint64) (q []uint64, r uint64) {
+	if y == 0 {
+		panic(errDivisionByZero)
+	}
+	q = make([]uint64, len(x))
+	for i := len(x) - 1; i >= 0; i-- {
+		q[i], r = bits.Div64(r, x[i], y)
+	}
+	return RemoveMostSignificantZeroesFromWords(q), r
+}
+
+// divWords returns the quotient and remainder of u / v using Knuth's
+// algorithm D (The Art of Computer Programming, Vol. 2, 4.3.1).
+func divWords(u, v []uint64) (q, r []uint64) {
+	u = RemoveMostSignificantZeroesFromWords(u)
+	v = RemoveMostSignificantZeroesFromWords(v)
+	if len(v) == 0 {
+		panic(errDivisionByZero)
+	}
+	if cmpWords(u, v) < 0 {
+		r = make([]uint64, len(u))
+		copy(r, u)
+		return []uint64{}, r
+	}
+	if len(v) == 1 {
+		var rWord uint64
+		q, rWord = divWordsUint64(u, v[0])
+		return q, RemoveMostSignificantZeroesFromWords([]uint64{rWord})
+	}
+
+	// normalize so that the most significant bit of the divisor is set
+	n := len(v)
+	m := len(u) - n
+	s := uint(bits.LeadingZeros64(v[n-1]))
+	vn := shlWords(v, s, n)
+	un := shlWords(u, s, len(u)+1)
+
+	q = make([]uint64, m+1)
+	for j := m; j >= 0; j-- {
+		// estimate the quotient word from the top two words of the remainder
+		qHat := ^uint64(0)
+		var rHat, c uint64
+		if un[j+n] == vn[n-1] {
+			rHat, c = bits.Add64(un[j+n-1], vn[n-1], 0)
+		} else {
+			qHat, rHat = bits.Div64(un[j+n], un[j+n-1], vn[n-1])
+		}
+		for c == 0 {
+			hi, lo := bits.Mul64(qHat, vn[n-2])
+			if hi < rHat || (hi == rHat && lo <= un[j+n-2]) {
+				break
+			}
+			qHat--
+			rHat, c = bits.Add64(rHat, vn[n-1], 0)
+		}
+
+		// multiply and subtract
+		var borrow, carry uint64
+		for i := 0; i < n; i++ {
+			hi, lo := bits.Mul64(qHat, vn[i])
+			lo, c = bits.Add64(lo, carry, 0)
+			carry = hi + c
+			un[i+j], borrow = bits.Sub64(un[i+j], lo, borrow)
+		}
+		un[j+n], borrow = bits.Sub64(un[j+n], carry, borrow)
+
+		// the estimate was one too large, add the divisor back
+		if borrow != 0 {
+			qHat--
+			c = 0
+			for i := 0; i < n; i++ {
+				un[i+j], c = bits.Add64(un[i+j], vn[i], c)
+			}
+			un[j+n] += c
+		}
+		q[j] = qHat
+	}
+
+	return RemoveMostSignificantZeroesFromWords(q), shrWords(un[:n], s)
+}
+
+// padWords returns x extended with most significant zero words up to size.
+func padWords(x []uint64, size int) []uint64 {
+	if len(x) >= size {
+		return x
+	}
+	out := make([]uint64, size)
+	copy(out, x)
+	return out
+}
+
+const errNotUnbounded = "lebig: Not needs a width"
+
+const errRotateUnbounded = "lebig: Rotate needs a width"
+
+// rotateWidth returns the width a rotation works in, the declared width
+// when width is 0.
+func rotateWidth(width, declared uint) uint {
+	if width == 0 {
+		width = declared
+	}
+	if width == 0 {
+		panic(errRotateUnbounded)
+	}
+	return width
+}
+
+// rotateLeftWords returns the low width bits of x rotated left by n, n <
+// width. Each word is rotated by n%64 so the bits it carries into the next
+// word are already in place; what ends up above width wraps to the bottom.
+func rotateLeftWords(x []uint64, n, width uint) []uint64 {
+	size := int(sizeInWordsFromBits(width))
+	wordShift, bitShift := int(n/64), n%64
+	low := uint64(1)<<bitShift - 1
+	shifted := make([]uint64, 2*size+1)
+	var carry uint64
+	for i := 0; i < size; i++ {
+		var word uint64
+		if i < len(x) {
+			word = x[i]
+		}
+		if partial := width % 64; i == size-1 && partial != 0 {
+			word &= 1<<partial - 1
+		}
+		rotated := bits.RotateLeft64(word, int(bitShift))
+		shifted[i+wordShift] = rotated&^low | carry
+		carry = rotated & low
+	}
+	shifted[size+wordShift] = carry
+	wrapped := rshWords(append([]uint64(nil), shifted...), width)
+	out := shifted[:size]
+	if partial := width % 64; partial != 0 {
+		out[size-1] &= 1<<partial - 1
+	}
+	for i, word := range wrapped {
+		out[i] |= word
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+const (
+	errSignedUnbounded = "lebig: signed operation needs a width"
+	errSignExtend      = "lebig: SignExtend needs 0 < fromBits <= toBits"
+	errTruncate        = "lebig: Truncate needs 0 < bits <= width"
+)
+
+func checkSignExtend(fromBits, toBits uint) {
+	if fromBits == 0 || fromBits > toBits {
+		panic(errSignExtend)
+	}
+}
+
+func checkTruncate(width, bits uint) {
+	if bits == 0 || (width > 0 && bits > width) {
+		panic(errTruncate)
+	}
+}
+
+// setOnesWords sets bits [hi-1:lo] of x, which must hold at least hi bits.
+func setOnesWords(x []uint64, lo, hi uint) {
+	for i := lo; i < hi; {
+		bit := i % 64
+		n := 64 - bit
+		if hi-i < n {
+			n = hi - i
+		}
+		x[i/64] |= ^uint64(0) >> (64 - n) << bit
+		i += n
+	}
+}
+
+func andWords(x, y []uint64) []uint64 {
+	if len(x) > len(y) {
+		x, y = y, x
+	}
+	out := make([]uint64, len(x))
+	for i := range out {
+		out[i] = x[i] & y[i]
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+func orWords(x, y []uint64) []uint64 {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	out := make([]uint64, len(x))
+	copy(out, x)
+	for i := range y {
+		out[i] |= y[i]
+	}
+	return out
+}
+
+func xorWords(x, y []uint64) []uint64 {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	out := make([]uint64, len(x))
+	copy(out, x)
+	for i := range y {
+		out[i] ^= y[i]
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+func andNotWords(x, y []uint64) []uint64 {
+	out := make([]uint64, len(x))
+	copy(out, x)
+	for i := range out {
+		if i < len(y) {
+			out[i] &^= y[i]
+		}
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+const errBitValue = "lebig: bit value must be 0 or 1"
+
+const (
+	errFieldRange   = "lebig: field hi bit is lower than lo bit"
+	errFieldTooWide = "lebig: field is wider than 64 bits"
+)
+
+func fieldWidth(hi, lo uint) uint {
+	if hi < lo {
+		panic(errFieldRange)
+	}
+	return hi - lo + 1
+}
+
+// fieldWords returns bits [hi:lo] of x.
+func fieldWords(x []uint64, hi, lo uint) []uint64 {
+	width := fieldWidth(hi, lo)
+	// only the bits of x from lo up need words, the field width covers the rest
+	bits := bitLenWords(x)
+	if bits <= lo {
+		return nil
+	}
+	if bits-lo < width {
+		width = bits - lo
+	}
+	out := make([]uint64, sizeInWordsFromBits(width))
+	start := int(lo / 64)
+	shift := lo % 64
+	for i := range out {
+		src := start + i
+		if src < len(x) {
+			out[i] = x[src] >> shift
+		}
+		if shift != 0 && src+1 < len(x) {
+			out[i] |= x[src+1] << (64 - shift)
+		}
+	}
+	if partial := width % 64; partial != 0 {
+		out[len(out)-1] &= 1<<partial - 1
+	}
+	return RemoveMostSignificantZeroesFromWords(out)
+}
+
+// setFieldWords overwrites bits [hi:lo] of x with the low bits of v, growing
+// x when hi is beyond its size. v must not share its array with x.
+func setFieldWords(x []uint64, hi, lo uint, v []uint64) []uint64 {
+	width := fieldWidth(hi, lo)
+	x = padWords(x, int(sizeInWordsFromBits(hi+1)))
+	start := int(lo / 64)
+	shift := lo % 64
+	for i := 0; i < int(sizeInWordsFromBits(width)); i++ {
+		mask := ^uint64(0)
+		if remaining := width - uint(i)*64; remaining < 64 {
+			mask = 1<<remaining - 1
+		}
+		var vWord uint64
+		if i < len(v) {
+			vWord = v[i] & mask
+		}
+		x[start+i] = x[start+i]&^(mask<<shift) | vWord<<shift
+		if shift != 0 && mask>>(64-shift) != 0 {
+			x[start+i+1] = x[start+i+1]&^(mask>>(64-shift)) | vWord>>(64-shift)
+		}
+	}
+	return x
+}
+
+// bytesOverflow reports whether the little endian bytes in have bits set at
+// or above width.
+func bytesOverflow(in []byte, width uint) bool {
+	for i := len(in) - 1; i >= 0; i-- {
+		if in[i] != 0 {
+			return uint(8*i+bits.Len8(in[i])) > width
+		}
+	}
+	return false
+}
+
+const errBase = "lebig: base must be between 2 and 36"
+
+func checkBase(base int) {
+	if base < 2 || base > 36 {
+		panic(errBase)
+	}
+}
+
+// textWords returns the digits of x in base, converting one uint64 chunk of
+// digits per division.
+func textWords(x []uint64, base int) string {
+	checkBase(base)
+	x = RemoveMostSignificantZeroesFromWords(x)
+	if len(x) == 0 {
+		return "0"
+	}
+
+	chunkBase, chunkDigits := uint64(base), 1
+	for chunkBase <= ^uint64(0)/uint64(base) {
+		chunkBase *= uint64(base)
+		chunkDigits++
+	}
+
+	var chunks []uint64
+	for len(x) > 0 {
+		var r uint64
+		x, r = divWordsUint64(x, chunkBase)
+		chunks = append(chunks, r)
+	}
+
+	out := []byte(strconv.FormatUint(chunks[len(chunks)-1], base))
+	for i := len(chunks) - 2; i >= 0; i-- {
+		chunk := strconv.FormatUint(chunks[i], base)
+		for pad := len(chunk); pad < chunkDigits; pad++ {
+			out = append(out, '0')
+		}
+		out = append(out, chunk...)
+	}
+	return string(out)
+}
+
+func bitLenWords(x []uint64) uint {
+	x = RemoveMostSignificantZeroesFromWords(x)
+	if len(x) == 0 {
+		return 0
+	}
+	return uint(64*(len(x)-1) + bits.Len64(x[len(x)-1]))
+}
+
+const errNegativeBig = "lebig: negative big.Int"
+
+func wordsFromBigWords(in []big.Word) []uint64 {
+	if bits.UintSize == 64 {
+		out := make([]uint64, len(in))
+		for i, word := range in {
+			out[i] = uint64(word)
+		}
+		return out
+	}
+	out := make([]uint64, (len(in)+1)/2)
+	for i, word := range in {
+		out[i/2] |= uint64(word) << (32 * uint(i%2))
+	}
+	return out
+}
+
+func bigWordsFromWords(in []uint64) []big.Word {
+	out := make([]big.Word, 0, len(in)*64/bits.UintSize)
+	for _, word := range in {
+		out = append(out, big.Word(word))
+		if bits.UintSize == 32 {
+			out = append(out, big.Word(word>>32))
+		}
+	}
+	return out
+}
+
+// wordsFromBytes sets z to the little endian bytes in, reading whole words
+// straight from in and reusing the capacity of z.
+func wordsFromBytes(z []uint64, in []byte) []uint64 {
+	size := (len(in) + 7) / 8
+	if cap(z) < size {
+		z = make([]uint64, size)
+	}
+	z = z[:size]
+	i := 0
+	for ; i+8 <= len(in); i += 8 {
+		z[i/8] = binary.LittleEndian.Uint64(in[i:])
+	}
+	if i < len(in) {
+		var last uint64
+		for j := len(in) - 1; j >= i; j-- {
+			last = last<<8 | uint64(in[j])
+		}
+		z[i/8] = last
+	}
+	return z
+}
+
+// bigWordsFromBytes is wordsFromBytes for the words of a big.Int.
+func bigWordsFromBytes(z []big.Word, in []byte) []big.Word {
+	const wordBytes = bits.UintSize / 8
+	size := (len(in) + wordBytes - 1) / wordBytes
+	if cap(z) < size {
+		z = make([]big.Word, size)
+	}
+	z = z[:size]
+	i := 0
+	for ; i+wordBytes <= len(in); i += wordBytes {
+		if wordBytes == 8 {
+			z[i/wordBytes] = big.Word(binary.LittleEndian.Uint64(in[i:]))
+		} else {
+			z[i/wordBytes] = big.Word(binary.LittleEndian.Uint32(in[i:]))
+		}
+	}
+	if i < len(in) {
+		var last big.Word
+		for j := len(in) - 1; j >= i; j-- {
+			last = last<<8 | big.Word(in[j])
+		}
+		z[i/wordBytes] = last
+	}
+	return z
+}
+
+// putWordBytes writes the little endian bytes of words to all of out,
+// truncating or padding with zeros.
+func putWordBytes(out []byte, words []uint64) {
+	i := 0
+	for ; i+8 <= len(out) && i/8 < len(words); i += 8 {
+		binary.LittleEndian.PutUint64(out[i:], words[i/8])
+	}
+	for ; i < len(out); i++ {
+		var word uint64
+		if i/8 < len(words) {
+			word = words[i/8]
+		}
+		out[i] = byte(word >> (8 * uint(i%8)))
+	}
+}
+
+// putBigWordBytes is putWordBytes for the words of a big.Int.
+func putBigWordBytes(out []byte, words []big.Word) {
+	const wordBytes = bits.UintSize / 8
+	i := 0
+	for ; i+wordBytes <= len(out) && i/wordBytes < len(words); i += wordBytes {
+		if wordBytes == 8 {
+			binary.LittleEndian.PutUint64(out[i:], uint64(words[i/wordBytes]))
+		} else {
+			binary.LittleEndian.PutUint32(out[i:], uint32(words[i/wordBytes]))
+		}
+	}
+	for ; i < len(out); i++ {
+		var word big.Word
+		if i/wordBytes < len(words) {
+			word = words[i/wordBytes]
+		}
+		out[i] = byte(word >> (8 * uint(i%wordBytes)))
+	}
+}
+
+// growBytes extends dst by n bytes, allocating only when dst has no room,
+// and returns it along with the n new bytes.
+func growBytes(dst []byte, n int) ([]byte, []byte) {
+	size := len(dst) + n
+	if cap(dst) < size {
+		grown := make([]byte, size, 2*len(dst)+n)
+		copy(grown, dst)
+		return grown, grown[len(dst):]
+	}
+	dst = dst[:size]
+	return dst, dst[size-n:]
+}
+
+func ReverseSliceOfBytes(in []byte) {
+	for i := len(in)/2 - 1; i >= 0; i-- {
+		opp := len(in) - 1 - i
+		//in[i], in[opp] = bits.Reverse8(in[opp]), bits.Reverse8(in[i])
+		in[i], in[opp] = in[opp], in[i]
+	}
+}
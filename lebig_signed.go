@@ -0,0 +1,142 @@
+package lebig
+
+const errSignedOverflow = "lebig: value does not fit in width"
+
+// SignedInt is a signed integer kept as an unbounded magnitude and a sign,
+// the zero value is 0. It converts to and from little endian two's
+// complement bytes of a given width.
+type SignedInt struct {
+	abs Int
+	neg bool
+}
+
+// NewSigned returns a SignedInt set to x.
+func NewSigned(x int64) *SignedInt {
+	signed := &SignedInt{}
+	signed.SetInt64(x)
+	return signed
+}
+
+func (this *SignedInt) SetInt64(x int64) {
+	abs := uint64(x)
+	if x < 0 {
+		abs = -abs
+	}
+	this.abs.SetUint64(abs)
+	this.neg = x < 0
+}
+
+// Int64 returns the low 64 bits of this as an int64, the result is
+// undefined when this does not fit.
+func (this *SignedInt) Int64() int64 {
+	x := int64(this.abs.Uint64())
+	if this.neg {
+		return -x
+	}
+	return x
+}
+
+// SetInt sets this to the unsigned x.
+func (this *SignedInt) SetInt(x *Int) {
+	this.abs.SetWords(x.Words())
+	this.neg = false
+}
+
+// Abs returns the magnitude of this in a new Int.
+func (this *SignedInt) Abs() *Int {
+	abs := NewWithBackend(this.abs.Backend())
+	abs.SetWords(this.abs.Words())
+	return abs
+}
+
+// Neg negates this.
+func (this *SignedInt) Neg() {
+	this.neg = !this.neg && !this.abs.IsZero()
+}
+
+// Sign returns -1 if this is negative, 0 if it is zero and +1 otherwise.
+func (this *SignedInt) Sign() int {
+	switch {
+	case this.abs.IsZero():
+		return 0
+	case this.neg:
+		return -1
+	}
+	return 1
+}
+
+// Cmp compares this and in and returns -1 if this < in, 0 if this == in
+// and +1 if this > in.
+func (this *SignedInt) Cmp(in *SignedInt) int {
+	if this.Sign() != in.Sign() {
+		if this.Sign() < in.Sign() {
+			return -1
+		}
+		return 1
+	}
+	if this.neg {
+		return in.abs.Cmp(&this.abs)
+	}
+	return this.abs.Cmp(&in.abs)
+}
+
+func (this *SignedInt) Text(base int) string {
+	if this.Sign() < 0 {
+		return "-" + this.abs.Text(base)
+	}
+	return this.abs.Text(base)
+}
+
+func (this *SignedInt) String() string {
+	return this.Text(10)
+}
+
+// SetBytes sets this to the low width bits of the little endian two's
+// complement bytes in. A width of 0 uses all of in.
+func (this *SignedInt) SetBytes(in []byte, width uint) {
+	if width == 0 {
+		width = 8 * uint(len(in))
+	}
+	if width == 0 {
+		this.SetInt64(0)
+		return
+	}
+	value := NewWidth(width)
+	value.SetBytes(in)
+	this.neg = value.Bit(width-1) == 1
+	if this.neg {
+		value.Not(0)
+		value.AddUint64(1)
+	}
+	this.abs.SetWords(value.Words())
+}
+
+// Bytes returns this as little endian two's complement in ceil(width/8)
+// bytes, the bits above width repeat the sign. A width of 0 uses the fewest
+// bytes that hold this. It panics if this does not fit in width bits.
+func (this *SignedInt) Bytes(width uint) []byte {
+	if width == 0 {
+		width = 8 * sizeInBytes(this.bitLen())
+	}
+	if this.bitLen() > width {
+		panic(errSignedOverflow)
+	}
+	value := NewWidth(8 * sizeInBytes(width))
+	value.SetWords(this.abs.Words())
+	if this.Sign() < 0 {
+		value.Not(0)
+		value.AddUint64(1)
+	}
+	return value.Bytes()
+}
+
+// bitLen is the number of bits this needs in two's complement, sign
+// included.
+func (this *SignedInt) bitLen() uint {
+	bitLen := this.abs.BitLen()
+	if this.Sign() < 0 && this.abs.TrailingZeros() == bitLen-1 {
+		// -2^n needs no more bits than 2^n - 1
+		return bitLen
+	}
+	return bitLen + 1
+}
@@ -0,0 +1,120 @@
+package lebig_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+// randomSignedBig returns a value that fits in width bits of two's
+// complement.
+func randomSignedBig(width uint) *big.Int {
+	out := new(big.Int).Rand(rand.New(rand.NewSource(rand.Int63())), new(big.Int).Lsh(big.NewInt(1), width))
+	return out.Sub(out, new(big.Int).Lsh(big.NewInt(1), width-1))
+}
+
+// twosComplementBytes returns in as little endian two's complement bytes.
+func twosComplementBytes(in *big.Int, size int) []byte {
+	out := new(big.Int).Set(in)
+	if out.Sign() < 0 {
+		out.Add(out, new(big.Int).Lsh(big.NewInt(1), uint(8*size)))
+	}
+	outBytes := make([]byte, size)
+	copy(outBytes, bytesFromBig(out))
+	return outBytes
+}
+
+func TestSignedBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300) + 1)
+		value := randomSignedBig(width)
+		size := int(width+7) / 8
+		expected := twosComplementBytes(value, size)
+
+		signed := lebig.SignedInt{}
+		signed.SetBytes(expected, width)
+		if signed.String() != value.String() {
+			t.Fatalf("%d: SetBytes(%x, %d): got %s, expected %s", x, expected, width, signed.String(), value)
+		}
+		checkSlices(t, expected, signed.Bytes(width), x)
+
+		fitting := signed.Bytes(0)
+		roundTrip := lebig.SignedInt{}
+		roundTrip.SetBytes(fitting, 0)
+		if roundTrip.Cmp(&signed) != 0 || len(fitting) > size {
+			t.Fatalf("%d: Bytes(0) of %s gives %x", x, value, fitting)
+		}
+	}
+}
+
+func TestSignedBytesOverflow(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	cases := []struct {
+		value int64
+		width uint
+		fits  bool
+	}{
+		{127, 8, true},
+		{128, 8, false},
+		{-128, 8, true},
+		{-129, 8, false},
+		{-2048, 12, true},
+		{2048, 12, false},
+		{0, 1, true},
+		{-1, 1, true},
+		{1, 1, false},
+	}
+	for _, c := range cases {
+		func() {
+			defer func() {
+				if r := recover(); (r == nil) != c.fits {
+					t.Errorf("%d in %d bits: fits %v, panic %v", c.value, c.width, c.fits, r)
+				}
+			}()
+			lebig.NewSigned(c.value).Bytes(c.width)
+		}()
+	}
+	checkSlices(t, []byte{0x00, 0xf8}, lebig.NewSigned(-2048).Bytes(12), 0)
+	checkSlices(t, []byte{0x80}, lebig.NewSigned(-128).Bytes(0), 0)
+	checkSlices(t, []byte{0x80, 0x00}, lebig.NewSigned(128).Bytes(0), 0)
+	checkSlices(t, []byte{0x00}, lebig.NewSigned(0).Bytes(0), 0)
+}
+
+func TestSignedNegAbsSign(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for _, x := range []int64{0, 1, -1, 12345, -98765, math.MaxInt64, math.MinInt64} {
+		signed := lebig.NewSigned(x)
+		if signed.Int64() != x || signed.String() != big.NewInt(x).String() {
+			t.Errorf("%d: got %d %s", x, signed.Int64(), signed)
+		}
+		if signed.Sign() != big.NewInt(x).Sign() {
+			t.Errorf("%d: Sign %d", x, signed.Sign())
+		}
+		abs := new(big.Int).Abs(big.NewInt(x))
+		if signed.Abs().String() != abs.String() {
+			t.Errorf("%d: Abs %s", x, signed.Abs())
+		}
+		signed.Neg()
+		if signed.String() != new(big.Int).Neg(big.NewInt(x)).String() {
+			t.Errorf("%d: Neg %s", x, signed)
+		}
+		if signed.Cmp(lebig.NewSigned(x)) != -big.NewInt(x).Sign() {
+			t.Errorf("%d: Cmp after Neg %d", x, signed.Cmp(lebig.NewSigned(x)))
+		}
+	}
+	unsigned := lebig.Int{}
+	unsigned.SetUint64(7)
+	signed := lebig.SignedInt{}
+	signed.SetInt(&unsigned)
+	signed.Neg()
+	if signed.Int64() != -7 || signed.Bytes(4)[0] != 0xf9 {
+		t.Errorf("SetInt and Neg of 7: %s %x", signed.String(), signed.Bytes(4))
+	}
+}
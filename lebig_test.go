@@ -60,6 +60,70 @@ func BenchmarkSetBytes(b *testing.B) {
 	}
 }
 
+func BenchmarkAppendBytes(b *testing.B) {
+	theSlice := make([]byte, 512)
+	for i := range theSlice {
+		theSlice[i] = byte(i)
+	}
+	anInt := lebig.Int{}
+	anInt.SetBytes(theSlice)
+	buf := make([]byte, 0, len(theSlice))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		buf = anInt.AppendBytes(buf[:0])
+	}
+}
+
+func TestBytesAllocs(t *testing.T) {
+	t.Log(t.Name())
+	in := randomBytes(512)
+	in[len(in)-1] |= 1
+	buf := make([]byte, 0, len(in))
+	for _, backend := range backends {
+		anInt := lebig.NewWithBackend(backend)
+		anInt.SetBytes(in)
+		allocs := testing.AllocsPerRun(100, func() {
+			anInt.SetBytes(in)
+			buf = anInt.AppendBytes(buf[:0])
+			anInt.PutBytes(buf)
+		})
+		if allocs != 0 {
+			t.Errorf("%s: %v allocations per SetBytes, AppendBytes and PutBytes", backend.Name(), allocs)
+		}
+		checkSlices(t, in, buf, 0)
+	}
+}
+
+func TestAppendBytesAndPutBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(2)) * uint(rand.Intn(300)+1)
+		prefix := randomBytes(10)
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randomBytes(50))
+		expected := anInt.Bytes()
+
+		out := anInt.AppendBytes(append([]byte(nil), prefix...))
+		checkSlices(t, prefix, out[:len(prefix)], x)
+		checkSlices(t, expected, out[len(prefix):], x)
+
+		buf := randomBytes(60)
+		buf = append(buf, make([]byte, 60)...)
+		if n := anInt.PutBytes(buf); n != len(expected) {
+			t.Fatalf("%d: PutBytes wrote %d bytes, expected %d", x, n, len(expected))
+		}
+		checkSlices(t, expected, buf[:len(expected)], x)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected a panic for a short buffer")
+		}
+	}()
+	anInt := lebig.NewWidth(72)
+	anInt.PutBytes(make([]byte, 8))
+}
+
 func BenchmarkSetBytesBigInt(b *testing.B) {
 
 	sizeInBytes := 512
@@ -251,6 +315,27 @@ func BenchmarkShiftLeft(b *testing.B) {
 	}
 }
 
+func benchmarkShiftLeftWide(b *testing.B, backend lebig.Backend) {
+	theSlice := make([]byte, 4096)
+	for i := range theSlice {
+		theSlice[i] = byte(i)
+	}
+	anInt := lebig.NewWithBackend(backend)
+	for n := 0; n < b.N; n++ {
+		anInt.SetBytes(theSlice)
+		anInt.ShiftLeft(8000)
+		anInt.ShiftRight(8000)
+	}
+}
+
+func BenchmarkShiftLeftWideWords(b *testing.B) {
+	benchmarkShiftLeftWide(b, lebig.WordsBackend)
+}
+
+func BenchmarkShiftLeftWideBig(b *testing.B) {
+	benchmarkShiftLeftWide(b, lebig.BigBackend)
+}
+
 func TestShiftRightSmall(t *testing.T) {
 	t.Parallel()
 	//const globalRepeat = 100000
@@ -477,6 +562,24 @@ func TestAndUint64(t *testing.T) {
 	}
 }
 
+func TestAndUint64Wide(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(100)
+		operand := rand.Uint64()
+		aBigInt := new(big.Int).And(bigFromBytes(randBytes), new(big.Int).SetUint64(operand))
+		for _, backend := range backends {
+			anInt := lebig.NewWithBackend(backend)
+			anInt.SetBytes(randBytes)
+			anInt.AndUint64(operand)
+			if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 {
+				t.Fatalf("%d: %s: %x & %x: got %x, expected %x", x, backend.Name(), randBytes, operand, anInt.Bytes(), bytesFromBig(aBigInt))
+			}
+		}
+	}
+}
+
 func TestIntOrUint64(t *testing.T) {
 	t.Parallel()
 	//const globalRepeat = 1000
@@ -544,6 +647,971 @@ func TestReverseSliceOfBytes(t *testing.T) {
 
 }
 
+func TestAdd(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(1000)
+		randBytesOperand := randomBytes(1000)
+
+		// set int bytes
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		anInt.Add(&operand)
+		outBytes := anInt.Bytes()
+
+		// set big int bytes
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigInt.Add(aBigInt, bigFromBytes(randBytesOperand))
+
+		checkSlices(t, bytesFromBig(aBigInt), outBytes, x)
+	}
+}
+
+func TestAddUint64AndBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(100)
+		randBytesOperand := randomBytes(100)
+		randUintOperand := rand.Uint64()
+
+		// set int bytes
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		anInt.AddUint64(randUintOperand)
+		anInt.AddBytes(randBytesOperand)
+		outBytes := anInt.Bytes()
+
+		// set big int bytes
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigInt.Add(aBigInt, new(big.Int).SetUint64(randUintOperand))
+		aBigInt.Add(aBigInt, bigFromBytes(randBytesOperand))
+
+		checkSlices(t, bytesFromBig(aBigInt), outBytes, x)
+	}
+}
+
+func TestAddCarry(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.Int{}
+	anInt.SetBytes([]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF})
+	anInt.AddUint64(1)
+	checkSlices(t, []byte{0, 0, 0, 0, 0, 0, 0, 0, 1}, anInt.Bytes(), 0)
+}
+
+func TestSub(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(1000)
+		randBytesOperand := randomBytes(1000)
+
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigIntOperand := bigFromBytes(randBytesOperand)
+		if aBigInt.Cmp(aBigIntOperand) < 0 {
+			randBytesInital, randBytesOperand = randBytesOperand, randBytesInital
+			aBigInt, aBigIntOperand = aBigIntOperand, aBigInt
+		}
+
+		// set int bytes
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		anInt.Sub(&operand)
+		outBytes := anInt.Bytes()
+
+		aBigInt.Sub(aBigInt, aBigIntOperand)
+		checkSlices(t, bytesFromBig(aBigInt), outBytes, x)
+	}
+}
+
+func TestSubUint64AndBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesOperand := randomBytes(100)
+		randBytesInital := make([]byte, len(randBytesOperand)+8)
+		copy(randBytesInital, randomBytes(len(randBytesInital)))
+		randBytesInital = append(randBytesInital, 1)
+		randUintOperand := rand.Uint64()
+
+		// set int bytes
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		anInt.SubBytes(randBytesOperand)
+		anInt.SubUint64(randUintOperand)
+		outBytes := anInt.Bytes()
+
+		// set big int bytes
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigInt.Sub(aBigInt, bigFromBytes(randBytesOperand))
+		aBigInt.Sub(aBigInt, new(big.Int).SetUint64(randUintOperand))
+
+		checkSlices(t, bytesFromBig(aBigInt), outBytes, x)
+	}
+}
+
+func TestSubUnderflow(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic on subtraction underflow")
+		}
+	}()
+	anInt := lebig.Int{}
+	anInt.SetUint64(1)
+	anInt.SubUint64(2)
+}
+
+func TestMul(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(500)
+		randBytesOperand := randomBytes(500)
+
+		// set int bytes
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		anInt.Mul(&operand)
+		outBytes := anInt.Bytes()
+
+		// set big int bytes
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigInt.Mul(aBigInt, bigFromBytes(randBytesOperand))
+
+		checkSlices(t, bytesFromBig(aBigInt), outBytes, x)
+	}
+}
+
+func TestMulUint64AndBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(100)
+		randBytesOperand := randomBytes(100)
+		randUintOperand := rand.Uint64()
+
+		// set int bytes
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		anInt.MulUint64(randUintOperand)
+		anInt.MulBytes(randBytesOperand)
+		outBytes := anInt.Bytes()
+
+		// set big int bytes
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigInt.Mul(aBigInt, new(big.Int).SetUint64(randUintOperand))
+		aBigInt.Mul(aBigInt, bigFromBytes(randBytesOperand))
+
+		checkSlices(t, bytesFromBig(aBigInt), outBytes, x)
+	}
+}
+
+func TestDivMod(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(500)
+		randBytesOperand := randomBytes(300)
+		randBytesOperand[len(randBytesOperand)-1] |= 1
+
+		// set int bytes
+		quotient := lebig.Int{}
+		quotient.SetBytes(randBytesInital)
+		remainder := lebig.Int{}
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		quotient.DivMod(&operand, &remainder)
+
+		// set big int bytes
+		aBigQuotient := new(big.Int)
+		aBigRemainder := new(big.Int)
+		aBigQuotient.QuoRem(bigFromBytes(randBytesInital), bigFromBytes(randBytesOperand), aBigRemainder)
+
+		checkSlices(t, bytesFromBig(aBigQuotient), quotient.Bytes(), x)
+		checkSlices(t, bytesFromBig(aBigRemainder), remainder.Bytes(), x)
+	}
+}
+
+func TestDivAndMod(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomEdgeBytes(40)
+		randBytesOperand := randomEdgeBytes(24)
+		randBytesOperand[len(randBytesOperand)-1] |= 1
+
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		quotient := lebig.Int{}
+		quotient.SetBytes(randBytesInital)
+		quotient.Div(&operand)
+		remainder := lebig.Int{}
+		remainder.SetBytes(randBytesInital)
+		remainder.Mod(&operand)
+
+		aBigQuotient := new(big.Int)
+		aBigRemainder := new(big.Int)
+		aBigQuotient.QuoRem(bigFromBytes(randBytesInital), bigFromBytes(randBytesOperand), aBigRemainder)
+
+		checkSlices(t, bytesFromBig(aBigQuotient), quotient.Bytes(), x)
+		checkSlices(t, bytesFromBig(aBigRemainder), remainder.Bytes(), x)
+	}
+}
+
+func TestDivUint64AndModUint64(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(200)
+		randUintOperand := rand.Uint64()>>uint(rand.Intn(64)) | 1
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		mod := anInt.ModUint64(randUintOperand)
+		rem := anInt.DivUint64(randUintOperand)
+
+		aBigQuotient := new(big.Int)
+		aBigRemainder := new(big.Int)
+		aBigQuotient.QuoRem(bigFromBytes(randBytesInital), new(big.Int).SetUint64(randUintOperand), aBigRemainder)
+
+		if mod != aBigRemainder.Uint64() || rem != aBigRemainder.Uint64() {
+			t.Error("remainder not Equal on repetition: ", x)
+			t.Error(mod, rem, aBigRemainder.Uint64())
+			t.FailNow()
+		}
+		checkSlices(t, bytesFromBig(aBigQuotient), anInt.Bytes(), x)
+	}
+}
+
+func TestDivByZero(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	divisions := map[string]func(anInt *lebig.Int){
+		"Div":       func(anInt *lebig.Int) { anInt.Div(&lebig.Int{}) },
+		"Mod":       func(anInt *lebig.Int) { anInt.Mod(&lebig.Int{}) },
+		"DivMod":    func(anInt *lebig.Int) { anInt.DivMod(&lebig.Int{}, &lebig.Int{}) },
+		"DivUint64": func(anInt *lebig.Int) { anInt.DivUint64(0) },
+		"ModUint64": func(anInt *lebig.Int) { anInt.ModUint64(0) },
+	}
+	for name, division := range divisions {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected panic on division by zero in", name)
+				}
+			}()
+			anInt := lebig.Int{}
+			anInt.SetUint64(42)
+			division(&anInt)
+		}()
+	}
+}
+
+func TestWidthBytes(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300) + 1)
+		randBytes := randomBytes(50)
+
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randBytes)
+		outBytes := anInt.Bytes()
+
+		if len(outBytes) != (int(width)+7)/8 {
+			t.Error("wrong length on repetition: ", x, width, len(outBytes))
+			t.FailNow()
+		}
+		aBigInt := truncateBig(bigFromBytes(randBytes), width)
+		if bigFromBytes(outBytes).Cmp(aBigInt) != 0 {
+			t.Error("not Equal on repetition: ", x, width)
+			t.Error(randBytes)
+			t.Error(outBytes)
+			t.FailNow()
+		}
+	}
+}
+
+func TestWidthWrapAround(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300) + 1)
+		randBytesInital := randomBytes(50)
+		randBytesOperand := randomBytes(50)
+		toShift := uint(rand.Intn(400))
+
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		aBigIntOperand := bigFromBytes(randBytesOperand)
+
+		operations := []struct {
+			name  string
+			lebig func(anInt *lebig.Int)
+			big   func(aBigInt *big.Int)
+		}{
+			{"Add", func(anInt *lebig.Int) { anInt.Add(&operand) }, func(aBigInt *big.Int) { aBigInt.Add(aBigInt, aBigIntOperand) }},
+			{"Sub", func(anInt *lebig.Int) { anInt.Sub(&operand) }, func(aBigInt *big.Int) { aBigInt.Sub(aBigInt, aBigIntOperand) }},
+			{"Mul", func(anInt *lebig.Int) { anInt.Mul(&operand) }, func(aBigInt *big.Int) { aBigInt.Mul(aBigInt, aBigIntOperand) }},
+			{"OrBytes", func(anInt *lebig.Int) { anInt.OrBytes(randBytesOperand) }, func(aBigInt *big.Int) { aBigInt.Or(aBigInt, aBigIntOperand) }},
+			{"ShiftLeft", func(anInt *lebig.Int) { anInt.ShiftLeft(toShift) }, func(aBigInt *big.Int) { aBigInt.Lsh(aBigInt, toShift) }},
+		}
+
+		for _, operation := range operations {
+			anInt := lebig.NewWidth(width)
+			anInt.SetBytes(randBytesInital)
+			operation.lebig(anInt)
+
+			aBigInt := truncateBig(bigFromBytes(randBytesInital), width)
+			operation.big(aBigInt)
+			aBigInt = truncateBig(aBigInt, width)
+
+			if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 {
+				t.Error(operation.name, " not Equal on repetition: ", x, width)
+				t.Error(anInt.Bytes())
+				t.Error(aBigInt)
+				t.FailNow()
+			}
+		}
+	}
+}
+
+func TestSetWidth(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.Int{}
+	anInt.SetUint64(0x1FFF)
+	anInt.SetWidth(13)
+	anInt.AddUint64(1)
+	checkSlices(t, []byte{0, 0}, anInt.Bytes(), 0)
+	if anInt.Width() != 13 {
+		t.Error("wrong width", anInt.Width())
+	}
+
+	anInt.SubUint64(1)
+	checkSlices(t, []byte{0xFF, 0x1F}, anInt.Bytes(), 0)
+
+	anInt.SetWidth(4)
+	checkSlices(t, []byte{0x0F}, anInt.Bytes(), 0)
+}
+
+func TestBitwiseInt(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(300)
+		randBytesOperand := randomBytes(300)
+		randUintOperand := rand.Uint64()
+
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+		aBigIntOperand := bigFromBytes(randBytesOperand)
+		aBigUintOperand := new(big.Int).SetUint64(randUintOperand)
+
+		operations := []struct {
+			name  string
+			lebig func(anInt *lebig.Int)
+			big   func(aBigInt *big.Int)
+		}{
+			{"And", func(anInt *lebig.Int) { anInt.And(&operand) }, func(aBigInt *big.Int) { aBigInt.And(aBigInt, aBigIntOperand) }},
+			{"Or", func(anInt *lebig.Int) { anInt.Or(&operand) }, func(aBigInt *big.Int) { aBigInt.Or(aBigInt, aBigIntOperand) }},
+			{"Xor", func(anInt *lebig.Int) { anInt.Xor(&operand) }, func(aBigInt *big.Int) { aBigInt.Xor(aBigInt, aBigIntOperand) }},
+			{"XorBytes", func(anInt *lebig.Int) { anInt.XorBytes(randBytesOperand) }, func(aBigInt *big.Int) { aBigInt.Xor(aBigInt, aBigIntOperand) }},
+			{"XorUint64", func(anInt *lebig.Int) { anInt.XorUint64(randUintOperand) }, func(aBigInt *big.Int) { aBigInt.Xor(aBigInt, aBigUintOperand) }},
+			{"AndNot", func(anInt *lebig.Int) { anInt.AndNot(&operand) }, func(aBigInt *big.Int) { aBigInt.AndNot(aBigInt, aBigIntOperand) }},
+		}
+
+		for _, operation := range operations {
+			anInt := lebig.Int{}
+			anInt.SetBytes(randBytesInital)
+			operation.lebig(&anInt)
+
+			aBigInt := bigFromBytes(randBytesInital)
+			operation.big(aBigInt)
+
+			if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 {
+				t.Error(operation.name, " not Equal on repetition: ", x)
+				t.Error(anInt.Bytes())
+				t.Error(bytesFromBig(aBigInt))
+				t.FailNow()
+			}
+		}
+	}
+}
+
+func TestNot(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300) + 1)
+		randBytes := randomBytes(50)
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+		anInt.Not(width)
+
+		fixedInt := lebig.NewWidth(width)
+		fixedInt.SetBytes(randBytes)
+		fixedInt.Not(0)
+
+		mask := new(big.Int).Lsh(big.NewInt(1), width)
+		mask.Sub(mask, big.NewInt(1))
+		aBigInt := new(big.Int).AndNot(mask, bigFromBytes(randBytes))
+
+		if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 || bigFromBytes(fixedInt.Bytes()).Cmp(aBigInt) != 0 {
+			t.Error("not Equal on repetition: ", x, width)
+			t.Error(anInt.Bytes())
+			t.Error(fixedInt.Bytes())
+			t.Error(bytesFromBig(aBigInt))
+			t.FailNow()
+		}
+	}
+}
+
+// rotateBig returns the low width bits of in rotated left by n.
+func rotateBig(in *big.Int, n, width uint) *big.Int {
+	n %= width
+	value := truncateBig(new(big.Int).Set(in), width)
+	wrapped := new(big.Int).Rsh(value, width-n)
+	return truncateBig(value.Lsh(value, n), width).Or(value, wrapped)
+}
+
+func TestRotate(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	widths := []uint{96, 128, 256}
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300) + 1)
+		if x%2 == 0 {
+			width = widths[rand.Intn(len(widths))]
+		}
+		n := uint(rand.Intn(600))
+		randBytes := randomBytes(50)
+
+		left := lebig.Int{}
+		left.SetBytes(randBytes)
+		left.RotateLeft(n, width)
+
+		right := lebig.NewWidth(width)
+		right.SetBytes(randBytes)
+		right.RotateRight(n, 0)
+
+		aBigInt := bigFromBytes(randBytes)
+		expectedLeft := rotateBig(aBigInt, n, width)
+		expectedRight := rotateBig(aBigInt, width-n%width, width)
+
+		if bigFromBytes(left.Bytes()).Cmp(expectedLeft) != 0 {
+			t.Fatalf("%d: %x rotated left by %d in %d bits: got %x, expected %x", x, randBytes, n, width, left.Bytes(), bytesFromBig(expectedLeft))
+		}
+		if bigFromBytes(right.Bytes()).Cmp(expectedRight) != 0 {
+			t.Fatalf("%d: %x rotated right by %d in %d bits: got %x, expected %x", x, randBytes, n, width, right.Bytes(), bytesFromBig(expectedRight))
+		}
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected a panic on an unbounded Int")
+		}
+	}()
+	anInt := lebig.Int{}
+	anInt.RotateLeft(1, 0)
+}
+
+// signedBig reads the low width bits of in as a two's complement value.
+func signedBig(in *big.Int, width uint) *big.Int {
+	out := truncateBig(new(big.Int).Set(in), width)
+	if out.Bit(int(width-1)) == 1 {
+		out.Sub(out, new(big.Int).Lsh(big.NewInt(1), width))
+	}
+	return out
+}
+
+func TestArithShiftRight(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(300) + 1)
+		shift := uint(rand.Intn(int(width) + 70))
+		randBytes := randomBytes(50)
+
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randBytes)
+		anInt.ArithShiftRight(shift)
+
+		aBigInt := signedBig(bigFromBytes(randBytes), width)
+		aBigInt = truncateBig(aBigInt.Rsh(aBigInt, shift), width)
+
+		if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 || anInt.Width() != width {
+			t.Fatalf("%d: %x >> %d on %d bits: got %x, expected %x", x, randBytes, shift, width, anInt.Bytes(), bytesFromBig(aBigInt))
+		}
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected a panic on an unbounded Int")
+		}
+	}()
+	anInt := lebig.Int{}
+	anInt.ArithShiftRight(1)
+}
+
+func TestSignExtend(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		fromBits := uint(rand.Intn(200) + 1)
+		toBits := fromBits + uint(rand.Intn(200))
+		randBytes := randomBytes(50)
+
+		width := uint(rand.Intn(2)) * (uint(rand.Intn(400)) + fromBits)
+
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randBytes)
+		anInt.SignExtend(fromBits, toBits)
+
+		aBigInt := truncateBig(signedBig(bigFromBytes(randBytes), fromBits), toBits)
+
+		if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 || anInt.Width() != toBits {
+			t.Fatalf("%d: %x from %d to %d bits: got %x, expected %x", x, randBytes, fromBits, toBits, anInt.Bytes(), bytesFromBig(aBigInt))
+		}
+	}
+	for _, c := range [][2]uint{{0, 8}, {9, 8}} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected a panic for", c)
+				}
+			}()
+			anInt := lebig.NewWidth(16)
+			anInt.SignExtend(c[0], c[1])
+		}()
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		width := uint(rand.Intn(2) * (rand.Intn(300) + 1))
+		bits := uint(rand.Intn(300) + 1)
+		if width > 0 && bits > width {
+			bits = width
+		}
+		randBytes := randomBytes(50)
+
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randBytes)
+		anInt.Truncate(bits)
+
+		aBigInt := truncateBig(bigFromBytes(randBytes), bits)
+		if bigFromBytes(anInt.Bytes()).Cmp(aBigInt) != 0 || anInt.Width() != bits {
+			t.Fatalf("%d: %x to %d bits: got %x, expected %x", x, randBytes, bits, anInt.Bytes(), bytesFromBig(aBigInt))
+		}
+	}
+	for _, bits := range []uint{0, 17} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("expected a panic for", bits)
+				}
+			}()
+			anInt := lebig.NewWidth(16)
+			anInt.Truncate(bits)
+		}()
+	}
+}
+
+func TestBitAccess(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(100)
+		i := uint(rand.Intn(len(randBytes)*8 + 200))
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+		aBigInt := bigFromBytes(randBytes)
+
+		if anInt.Bit(i) != aBigInt.Bit(int(i)) || anInt.TestBit(i) != (aBigInt.Bit(int(i)) == 1) {
+			t.Error("Bit not Equal on repetition: ", x, i)
+			t.FailNow()
+		}
+
+		anInt.FlipBit(i)
+		aBigInt.SetBit(aBigInt, int(i), aBigInt.Bit(int(i))^1)
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+
+		anInt.SetBit(i+1, 1)
+		aBigInt.SetBit(aBigInt, int(i+1), 1)
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+
+		anInt.ClearBit(i)
+		aBigInt.SetBit(aBigInt, int(i), 0)
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+
+		anInt.SetBit(i+1, 0)
+		aBigInt.SetBit(aBigInt, int(i+1), 0)
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+	}
+}
+
+func TestSetBitWidth(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.NewWidth(12)
+	anInt.SetBit(11, 1)
+	anInt.SetBit(12, 1)
+	anInt.FlipBit(100)
+	checkSlices(t, []byte{0x00, 0x08}, anInt.Bytes(), 0)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic on bit value 2")
+		}
+	}()
+	anInt.SetBit(0, 2)
+}
+
+func TestField(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(100)
+		lo := uint(rand.Intn(len(randBytes)*8 + 100))
+		hi := lo + uint(rand.Intn(300))
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+		field := anInt.Field(hi, lo)
+
+		aBigInt := new(big.Int).Rsh(bigFromBytes(randBytes), lo)
+		aBigInt = truncateBig(aBigInt, hi-lo+1)
+
+		if field.Width() != hi-lo+1 || bigFromBytes(field.Bytes()).Cmp(aBigInt) != 0 {
+			t.Error("not Equal on repetition: ", x, hi, lo)
+			t.Error(field.Bytes(), field.Width())
+			t.Error(bytesFromBig(aBigInt))
+			t.FailNow()
+		}
+
+		if hi-lo < 64 && anInt.FieldUint64(hi, lo) != aBigInt.Uint64() {
+			t.Error("FieldUint64 not Equal on repetition: ", x, hi, lo)
+			t.FailNow()
+		}
+	}
+
+	// a field far wider than the value only holds the bits of the value
+	anInt := lebig.Int{}
+	anInt.SetUint64(5)
+	hi := ^uint(0) >> 1
+	field := anInt.Field(hi, 0)
+	if field.Width() != hi+1 || field.Uint64() != 5 || field.WordLen() != 1 {
+		t.Error("wide field not Equal: ", field.Words(), field.Width())
+	}
+}
+
+func TestSetField(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(100)
+		randBytesField := randomBytes(50)
+		lo := uint(rand.Intn(len(randBytes)*8 + 100))
+		hi := lo + uint(rand.Intn(300))
+		width := hi - lo + 1
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+		field := lebig.Int{}
+		field.SetBytes(randBytesField)
+		anInt.SetField(hi, lo, &field)
+
+		mask := new(big.Int).Lsh(big.NewInt(1), width)
+		mask.Sub(mask, big.NewInt(1))
+		aBigInt := bigFromBytes(randBytes)
+		aBigInt.AndNot(aBigInt, new(big.Int).Lsh(mask, lo))
+		aBigField := new(big.Int).And(bigFromBytes(randBytesField), mask)
+		aBigInt.Or(aBigInt, aBigField.Lsh(aBigField, lo))
+
+		checkSlices(t, bytesFromBig(aBigInt), anInt.Bytes(), x)
+	}
+
+	// the field is read from the receiver itself
+	setFieldBig := func(in *big.Int, hi, lo uint) *big.Int {
+		mask := new(big.Int).Lsh(big.NewInt(1), hi-lo+1)
+		mask.Sub(mask, big.NewInt(1))
+		field := new(big.Int).And(in, mask)
+		out := new(big.Int).AndNot(in, new(big.Int).Lsh(mask, lo))
+		return out.Or(out, field.Lsh(field, lo))
+	}
+	spec := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17}
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(100)
+		lo := uint(rand.Intn(len(randBytes)*8 + 100))
+		hi := lo + uint(rand.Intn(300))
+		if x == 0 {
+			randBytes, hi, lo = spec, 140, 4
+		}
+		expected := bytesFromBig(setFieldBig(bigFromBytes(randBytes), hi, lo))
+		for _, backend := range backends {
+			anInt := lebig.NewWithBackend(backend)
+			anInt.SetBytes(randBytes)
+			anInt.SetField(hi, lo, anInt)
+			checkSlices(t, expected, anInt.Bytes(), x)
+		}
+	}
+}
+
+func TestFieldUint64(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.NewWidth(128)
+	anInt.SetFieldUint64(127, 120, 0xA5)
+	anInt.SetFieldUint64(67, 60, 0x1FF)
+	anInt.SetFieldUint64(3, 0, 0x3)
+	if v := anInt.FieldUint64(127, 120); v != 0xA5 {
+		t.Error("wrong field [127:120]", v)
+	}
+	if v := anInt.FieldUint64(67, 60); v != 0xFF {
+		t.Error("wrong field [67:60]", v)
+	}
+	if v := anInt.FieldUint64(63, 0); v != 0xF000000000000003 {
+		t.Errorf("wrong field [63:0] %x", v)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic on field wider than 64 bits")
+		}
+	}()
+	anInt.FieldUint64(64, 0)
+}
+
+func TestCmp(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytesInital := randomBytes(20)
+		randBytesOperand := randomBytes(20)
+		if rand.Intn(4) == 0 {
+			randBytesOperand = append(randBytesInital[:len(randBytesInital):len(randBytesInital)], 0, 0)
+		}
+		randUintOperand := rand.Uint64() >> uint(rand.Intn(64))
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytesInital)
+		operand := lebig.Int{}
+		operand.SetBytes(randBytesOperand)
+
+		aBigInt := bigFromBytes(randBytesInital)
+		aBigIntOperand := bigFromBytes(randBytesOperand)
+		cmp := aBigInt.Cmp(aBigIntOperand)
+
+		if anInt.Cmp(&operand) != cmp || anInt.Equal(&operand) != (cmp == 0) || anInt.Less(&operand) != (cmp < 0) {
+			t.Error("Cmp not Equal on repetition: ", x, anInt.Cmp(&operand), cmp)
+			t.FailNow()
+		}
+		if anInt.CmpUint64(randUintOperand) != aBigInt.Cmp(new(big.Int).SetUint64(randUintOperand)) {
+			t.Error("CmpUint64 not Equal on repetition: ", x)
+			t.FailNow()
+		}
+		if anInt.IsZero() != (aBigInt.Sign() == 0) || anInt.Sign() != aBigInt.Sign() {
+			t.Error("IsZero not Equal on repetition: ", x)
+			t.FailNow()
+		}
+	}
+}
+
+func TestIsZero(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.Int{}
+	if !anInt.IsZero() || anInt.Sign() != 0 || anInt.CmpUint64(0) != 0 {
+		t.Error("zero value is not zero")
+	}
+	anInt.SetBytes([]byte{0, 0, 0})
+	if !anInt.IsZero() {
+		t.Error("SetBytes of zeroes is not zero")
+	}
+	anInt.SetUint64(1)
+	anInt.ShiftLeft(200)
+	anInt.ShiftRight(201)
+	if !anInt.IsZero() || !anInt.Equal(&lebig.Int{}) {
+		t.Error("shifted out value is not zero")
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	one := lebig.Int{}
+	one.SetUint64(1)
+	operations := map[string]func(anInt *lebig.Int){
+		"SetBytesEmpty":   func(anInt *lebig.Int) { anInt.SetBytes([]byte{}) },
+		"SetBytesNil":     func(anInt *lebig.Int) { anInt.SetBytes(nil) },
+		"ShiftLeft":       func(anInt *lebig.Int) { anInt.ShiftLeft(100) },
+		"ShiftRight":      func(anInt *lebig.Int) { anInt.ShiftRight(100) },
+		"SmallShiftLeft":  func(anInt *lebig.Int) { anInt.SmallShiftLeft(3) },
+		"SmallShiftRight": func(anInt *lebig.Int) { anInt.SmallShiftRight(3) },
+		"AndUint64":       func(anInt *lebig.Int) { anInt.AndUint64(7) },
+		"AndBytes":        func(anInt *lebig.Int) { anInt.AndBytes([]byte{7}) },
+		"AndBytesEmpty":   func(anInt *lebig.Int) { anInt.AndBytes(nil) },
+		"OrUint64":        func(anInt *lebig.Int) { anInt.OrUint64(0) },
+		"OrBytes":         func(anInt *lebig.Int) { anInt.OrBytes([]byte{0}) },
+		"XorUint64":       func(anInt *lebig.Int) { anInt.XorUint64(0) },
+		"And":             func(anInt *lebig.Int) { anInt.And(&one) },
+		"AndNot":          func(anInt *lebig.Int) { anInt.AndNot(&one) },
+		"Add":             func(anInt *lebig.Int) { anInt.Add(&lebig.Int{}) },
+		"SubBytesEmpty":   func(anInt *lebig.Int) { anInt.SubBytes(nil) },
+		"Mul":             func(anInt *lebig.Int) { anInt.Mul(&one) },
+		"MulBytesEmpty":   func(anInt *lebig.Int) { anInt.MulBytes(nil) },
+		"Div":             func(anInt *lebig.Int) { anInt.Div(&one) },
+		"Mod":             func(anInt *lebig.Int) { anInt.Mod(&one) },
+		"DivUint64":       func(anInt *lebig.Int) { anInt.DivUint64(3) },
+		"ClearBit":        func(anInt *lebig.Int) { anInt.ClearBit(70) },
+		"FlipBitTwice":    func(anInt *lebig.Int) { anInt.FlipBit(70); anInt.FlipBit(70) },
+		"Field":           func(anInt *lebig.Int) { *anInt = *anInt.Field(10, 3) },
+		"SetFieldUint64":  func(anInt *lebig.Int) { anInt.SetFieldUint64(10, 3, 0) },
+	}
+	for name, operation := range operations {
+		anInt := lebig.Int{}
+		operation(&anInt)
+		if !anInt.IsZero() || anInt.Uint64() != 0 || anInt.Bit(0) != 0 || anInt.ModUint64(3) != 0 {
+			t.Error(name, " on zero value is not zero: ", anInt.Bytes())
+		}
+		checkSlices(t, []byte{}, anInt.Bytes(), 0)
+	}
+
+	anInt := lebig.Int{}
+	anInt.OrUint64(5)
+	if anInt.Uint64() != 5 {
+		t.Error("OrUint64 on zero value", anInt.Uint64())
+	}
+}
+
+func TestLengths(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(300)
+		if rand.Intn(10) == 0 {
+			randBytes = make([]byte, len(randBytes))
+		}
+		toShift := uint(rand.Intn(200))
+
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+		anInt.ShiftLeft(toShift)
+		aBigInt := new(big.Int).Lsh(bigFromBytes(randBytes), toShift)
+
+		bitLen := uint(aBigInt.BitLen())
+		if anInt.BitLen() != bitLen || anInt.ByteLen() != (bitLen+7)/8 || anInt.WordLen() != (bitLen+63)/64 {
+			t.Error("lengths not Equal on repetition: ", x, bitLen)
+			t.Error(anInt.BitLen(), anInt.ByteLen(), anInt.WordLen())
+			t.FailNow()
+		}
+		if anInt.TrailingZeros() != aBigInt.TrailingZeroBits() {
+			t.Error("TrailingZeros not Equal on repetition: ", x, anInt.TrailingZeros(), aBigInt.TrailingZeroBits())
+			t.FailNow()
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(300)
+		randBytes[0] |= 1
+		anInt := lebig.Int{}
+		anInt.SetBytes(randBytes)
+
+		words := anInt.Words()
+		if uint(len(words)) != anInt.WordLen() {
+			t.Error("wrong number of words on repetition: ", x, len(words), anInt.WordLen())
+			t.FailNow()
+		}
+		for i, word := range words {
+			if word != anInt.FieldUint64(uint(64*i+63), uint(64*i)) {
+				t.Error("word not Equal on repetition: ", x, i)
+				t.FailNow()
+			}
+		}
+
+		// the returned words are a copy
+		words[0] ^= 1
+		outInt := lebig.Int{}
+		outInt.SetWords(append(words, 0, 0))
+		words[0] ^= 1
+		outInt.XorUint64(1)
+		if !outInt.Equal(&anInt) {
+			t.Error("SetWords not Equal on repetition: ", x)
+			t.FailNow()
+		}
+	}
+
+	anInt := lebig.Int{}
+	if len(anInt.Words()) != 0 || anInt.BitLen() != 0 || anInt.TrailingZeros() != 0 {
+		t.Error("zero value lengths", anInt.Words(), anInt.BitLen())
+	}
+	fixedInt := lebig.NewWidth(8)
+	fixedInt.SetWords([]uint64{0x1FF})
+	if fixedInt.Uint64() != 0xFF || fixedInt.BitLen() != 8 {
+		t.Error("SetWords does not wrap", fixedInt)
+	}
+}
+
+func randomBytes(maxSize int) []byte {
+	randBytes := make([]byte, rand.Intn(maxSize)+1)
+	for i := range randBytes {
+		randBytes[i] = byte(rand.Intn(0xFF))
+	}
+	return randBytes
+}
+
+// randomEdgeBytes builds a value out of words that stress carries and
+// quotient estimation: zeroes, ones and all ones mixed with random words.
+func randomEdgeBytes(maxWords int) []byte {
+	edgeWords := []uint64{0, 1, 1 << 63, ^uint64(0), ^uint64(0) - 1}
+	randBytes := make([]byte, 8*(rand.Intn(maxWords)+1))
+	for i := 0; i < len(randBytes); i += 8 {
+		word := rand.Uint64()
+		if rand.Intn(2) == 0 {
+			word = edgeWords[rand.Intn(len(edgeWords))]
+		}
+		binary.LittleEndian.PutUint64(randBytes[i:], word)
+	}
+	randBytes[len(randBytes)-1] |= 0x80
+	return randBytes
+}
+
+// truncateBig returns in modulo 2^width.
+func truncateBig(in *big.Int, width uint) *big.Int {
+	modulus := new(big.Int).Lsh(big.NewInt(1), width)
+	return in.Mod(in, modulus)
+}
+
+func bigFromBytes(in []byte) *big.Int {
+	reversed := make([]byte, len(in))
+	copy(reversed, in)
+	lebig.ReverseSliceOfBytes(reversed)
+	return new(big.Int).SetBytes(reversed)
+}
+
+func bytesFromBig(in *big.Int) []byte {
+	out := in.Bytes()
+	lebig.ReverseSliceOfBytes(out)
+	return out
+}
+
 func checkSlices(t *testing.T, sIn, sOut []byte, count int) {
 	if !reflect.DeepEqual(sIn, sOut) {
 		diffOk := false
@@ -0,0 +1,137 @@
+package lebig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	errVerilogBase  = "lebig: Verilog base must be one of h, d, b or o"
+	errVerilogWidth = "lebig: value does not fit in the Verilog width"
+
+	// verilogUnsizedWidth is the minimum width of a literal without a size.
+	verilogUnsizedWidth = 32
+)
+
+// ParseVerilog returns the value of a Verilog sized literal such as
+// 128'hDEAD_BEEF, 8'b1010_0101 or 32'd17 as an Int of the declared width.
+// A literal without size gets a width of at least 32 bits, a literal
+// without base is decimal. Digits x and z are not supported, and a size
+// above MaxWidth or a value that does not fit in it is an error.
+func ParseVerilog(s string) (*Int, error) {
+	syntaxError := func(offset int, format string, args ...interface{}) error {
+		return &SyntaxError{Input: s, Offset: offset, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	width := uint(0)
+	base := 10
+	digitsOffset := 0
+	if tick := strings.IndexByte(s, '\''); tick >= 0 {
+		if tick > 0 {
+			size, err := strconv.ParseUint(s[:tick], 10, 0)
+			if err != nil || size == 0 {
+				return nil, syntaxError(0, "invalid size %q", s[:tick])
+			}
+			if size > MaxWidth {
+				return nil, syntaxError(0, "size %d is larger than %d", size, MaxWidth)
+			}
+			width = uint(size)
+		}
+
+		digitsOffset = tick + 1
+		if digitsOffset < len(s) && (s[digitsOffset] == 's' || s[digitsOffset] == 'S') {
+			digitsOffset++
+		}
+		if digitsOffset == len(s) {
+			return nil, syntaxError(digitsOffset, "missing base")
+		}
+		switch s[digitsOffset] {
+		case 'h', 'H':
+			base = 16
+		case 'd', 'D':
+			base = 10
+		case 'b', 'B':
+			base = 2
+		case 'o', 'O':
+			base = 8
+		default:
+			return nil, syntaxError(digitsOffset, "invalid base %q", s[digitsOffset])
+		}
+		digitsOffset++
+	}
+
+	// drop the separators, remembering where each digit came from
+	var digits []byte
+	var offsets []int
+	for i := digitsOffset; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '_' && len(digits) > 0:
+		case c == 'x' || c == 'X' || c == 'z' || c == 'Z' || c == '?':
+			return nil, syntaxError(i, "unsupported digit %q", c)
+		default:
+			digits = append(digits, c)
+			offsets = append(offsets, i)
+		}
+	}
+	offsets = append(offsets, len(s))
+
+	anInt, err := parseString(string(digits), base)
+	if err != nil {
+		syntaxErr := err.(*SyntaxError)
+		return nil, syntaxError(offsets[syntaxErr.Offset], "%s", syntaxErr.Msg)
+	}
+
+	bitLen := anInt.BitLen()
+	if width == 0 {
+		width = verilogUnsizedWidth
+		if bitLen > width {
+			width = bitLen
+		}
+	}
+	if bitLen > width {
+		return nil, syntaxError(digitsOffset, "value overflows %d bits", width)
+	}
+	anInt.SetWidth(width)
+	return anInt, nil
+}
+
+// FormatVerilog returns this as a Verilog sized literal in base 'h', 'd',
+// 'b' or 'o', with upper case hexadecimal digits for 'H'. A width of 0 uses
+// the width of this, or its bit length when this is unbounded. The digits of
+// binary, octal and hexadecimal literals are zero padded to the width. It
+// panics if this does not fit in width bits, as ParseVerilog would reject
+// the literal.
+func (this *Int) FormatVerilog(width uint, base byte) string {
+	var verb string
+	switch base {
+	case 'h':
+		verb = "%x"
+	case 'H':
+		verb = "%X"
+	case 'd', 'D':
+		verb = "%d"
+	case 'b', 'B':
+		verb = "%b"
+	case 'o', 'O':
+		verb = "%o"
+	default:
+		panic(errVerilogBase)
+	}
+
+	if width == 0 {
+		width = this.Width()
+	}
+	if width == 0 {
+		width = this.BitLen()
+	}
+	if width == 0 {
+		width = 1
+	}
+	if this.BitLen() > width {
+		panic(errVerilogWidth)
+	}
+	literal := NewWidth(width)
+	literal.SetBytes(this.Bytes())
+	return fmt.Sprintf("%d'%c"+verb, width, base, literal)
+}
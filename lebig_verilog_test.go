@@ -0,0 +1,118 @@
+package lebig_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/lagarciag/lebig"
+)
+
+func TestParseVerilog(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	cases := []struct {
+		literal string
+		width   uint
+		value   string
+	}{
+		{"128'hDEAD_BEEF", 128, "3735928559"},
+		{"8'b1010_0101", 8, "165"},
+		{"32'd17", 32, "17"},
+		{"12'o7_7_7_7", 12, "4095"},
+		{"16'sh8000", 16, "32768"},
+		{"4'B1__1", 4, "3"},
+		{"'hFF", 32, "255"},
+		{"'h1_0000_0000_0", 37, "68719476736"},
+		{"42", 32, "42"},
+		{"1'b0", 1, "0"},
+	}
+	for _, c := range cases {
+		anInt, err := lebig.ParseVerilog(c.literal)
+		if err != nil {
+			t.Errorf("%s: %v", c.literal, err)
+			continue
+		}
+		if anInt.Width() != c.width || anInt.String() != c.value {
+			t.Errorf("%s: got %d bits %s, expected %d bits %s", c.literal, anInt.Width(), anInt, c.width, c.value)
+		}
+	}
+}
+
+func TestParseVerilogErrors(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	cases := map[string]int{
+		"8'h100":    3,
+		"4'd16":     3,
+		"0'h0":      0,
+		"x'h0":      0,
+		"8'":        2,
+		"8'q12":     2,
+		"8'h":       3,
+		"8'h_1":     3,
+		"8'b10x1":   5,
+		"8'hzz":     3,
+		"16'hF_G":   6,
+		"8'd1_2_a3": 7,
+
+		"4611686018427387904'h1": 0,
+		"1073741825'h1":          0,
+	}
+	for literal, offset := range cases {
+		_, err := lebig.ParseVerilog(literal)
+		syntaxError, ok := err.(*lebig.SyntaxError)
+		if !ok {
+			t.Errorf("%q: expected a SyntaxError, got %v", literal, err)
+			continue
+		}
+		if syntaxError.Offset != offset {
+			t.Errorf("%q: wrong offset %d, expected %d: %v", literal, syntaxError.Offset, offset, err)
+		}
+	}
+}
+
+func TestFormatVerilog(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	anInt := lebig.NewWidth(13)
+	anInt.SetUint64(0x2A)
+	cases := []struct {
+		out      string
+		expected string
+	}{
+		{anInt.FormatVerilog(0, 'h'), "13'h002a"},
+		{anInt.FormatVerilog(0, 'H'), "13'H002A"},
+		{anInt.FormatVerilog(0, 'd'), "13'd42"},
+		{anInt.FormatVerilog(8, 'b'), "8'b00101010"},
+		{anInt.FormatVerilog(6, 'h'), "6'h2a"},
+		{anInt.FormatVerilog(0, 'o'), "13'o00052"},
+		{new(lebig.Int).FormatVerilog(0, 'h'), "1'h0"},
+	}
+	for _, c := range cases {
+		if c.out != c.expected {
+			t.Errorf("got %s, expected %s", c.out, c.expected)
+		}
+	}
+	if !panics(func() { anInt.FormatVerilog(4, 'h') }) {
+		t.Error("FormatVerilog truncated 42 to 4 bits")
+	}
+
+	for x := 0; x < globalRepeat; x++ {
+		randBytes := randomBytes(40)
+		width := uint(len(randBytes)*8 + rand.Intn(20))
+		anInt := lebig.NewWidth(width)
+		anInt.SetBytes(randBytes)
+		for _, base := range []byte{'h', 'H', 'd', 'b', 'o'} {
+			literal := anInt.FormatVerilog(0, base)
+			parsed, err := lebig.ParseVerilog(literal)
+			if err != nil {
+				t.Error("ParseVerilog failed on repetition: ", x, literal, err)
+				t.FailNow()
+			}
+			if parsed.Width() != width || !parsed.Equal(anInt) {
+				t.Error("not Equal on repetition: ", x, literal, parsed)
+				t.FailNow()
+			}
+		}
+	}
+}
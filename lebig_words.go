@@ -0,0 +1,520 @@
+package lebig
+
+import (
+	"math/big"
+
+	"math/bits"
+)
+
+// wordsInt is the Backend that keeps the value in a slice of little endian
+// uint64 words.
+type wordsInt struct {
+	sizeInBytes uint
+	sizeInBits  uint
+	sizeInWords uint
+	width       uint
+	abs         []uint64
+}
+
+func (this *wordsInt) New() Backend {
+	return &wordsInt{}
+}
+
+func (this *wordsInt) Name() string {
+	return "words"
+}
+
+// wordsFrom returns in as a wordsInt, converting the value of other backends.
+func wordsFrom(in Backend) *wordsInt {
+	if words, ok := in.(*wordsInt); ok {
+		return words
+	}
+	words := &wordsInt{}
+	words.setWords(in.Words())
+	return words
+}
+
+func (this *wordsInt) Width() uint {
+	return this.width
+}
+
+func (this *wordsInt) SetWidth(n uint) {
+	this.width = n
+	this.norm()
+}
+
+func (this *wordsInt) Words() []uint64 {
+	out := make([]uint64, this.sizeInWords)
+	copy(out, this.abs)
+	return out
+}
+
+func (this *wordsInt) SetWords(in []uint64) {
+	words := make([]uint64, len(in))
+	copy(words, in)
+	this.setWords(words)
+}
+
+func (this *wordsInt) BitLen() uint {
+	return this.sizeInBits
+}
+
+func (this *wordsInt) ByteLen() uint {
+	return this.sizeInBytes
+}
+
+func (this *wordsInt) WordLen() uint {
+	return this.sizeInWords
+}
+
+func (this *wordsInt) TrailingZeros() uint {
+	for i, word := range this.abs {
+		if word != 0 {
+			return uint(64*i + bits.TrailingZeros64(word))
+		}
+	}
+	return 0
+}
+
+// SetBytes sets this to the little endian bytes in, reusing the capacity of
+// abs.
+func (this *wordsInt) SetBytes(in []byte) {
+	this.abs = wordsFromBytes(this.abs, in)
+	this.norm()
+}
+
+func (this *wordsInt) SetUint64(in uint64) {
+	this.abs = append(this.abs[:0], in)
+	this.norm()
+}
+
+func (this *wordsInt) Uint64() uint64 {
+	if len(this.abs) > 0 {
+		return this.abs[0]
+	} else {
+		return 0
+	}
+
+}
+
+func (this *wordsInt) Bytes() []byte {
+	return this.AppendBytes(make([]byte, 0, this.bytesLen()))
+}
+
+func (this *wordsInt) AppendBytes(dst []byte) []byte {
+	dst, out := growBytes(dst, this.bytesLen())
+	putWordBytes(out, this.abs)
+	return dst
+}
+
+func (this *wordsInt) PutBytes(dst []byte) int {
+	size := this.bytesLen()
+	if len(dst) < size {
+		panic(errFillBytes)
+	}
+	putWordBytes(dst[:size], this.abs)
+	return size
+}
+
+// bytesLen is the length of Bytes, ceil(width/8) for a fixed width Int.
+func (this *wordsInt) bytesLen() int {
+	if this.width > 0 {
+		return int(sizeInBytes(this.width))
+	}
+	return int(this.sizeInBytes)
+}
+
+func (this *wordsInt) SmallShiftRight(sr uint) {
+	this.ShiftRight(sr)
+}
+
+func (this *wordsInt) SmallShiftLeft(sl uint) {
+	this.ShiftLeft(sl)
+}
+
+// ShiftLeft moves whole words and then shifts the remaining bits in a single
+// pass, growing abs at most once.
+func (this *wordsInt) ShiftLeft(sl uint) {
+	if this.width > 0 && sl >= this.width {
+		this.abs = this.abs[:0]
+	} else {
+		this.abs = lshWords(this.abs, sl)
+	}
+	this.norm()
+}
+
+// ShiftRight moves whole words and then shifts the remaining bits in a
+// single pass, in place.
+func (this *wordsInt) ShiftRight(sr uint) {
+	this.abs = rshWords(this.abs, sr)
+	this.norm()
+}
+
+func (this *wordsInt) ArithShiftRight(sr uint) {
+	if this.width == 0 {
+		panic(errSignedUnbounded)
+	}
+	negative := this.Bit(this.width-1) == 1
+	this.abs = rshWords(this.abs, sr)
+	if negative {
+		lo := uint(0)
+		if sr < this.width {
+			lo = this.width - sr
+		}
+		this.abs = padWords(this.abs, int(sizeInWordsFromBits(this.width)))
+		setOnesWords(this.abs, lo, this.width)
+	}
+	this.norm()
+}
+
+func (this *wordsInt) SignExtend(fromBits, toBits uint) {
+	checkSignExtend(fromBits, toBits)
+	this.width = fromBits
+	this.norm()
+	negative := this.Bit(fromBits-1) == 1
+	this.width = toBits
+	if negative {
+		this.abs = padWords(this.abs, int(sizeInWordsFromBits(toBits)))
+		setOnesWords(this.abs, fromBits, toBits)
+	}
+	this.norm()
+}
+
+func (this *wordsInt) Truncate(bits uint) {
+	checkTruncate(this.width, bits)
+	this.SetWidth(bits)
+}
+
+func (this *wordsInt) AndUint64(in uint64) {
+	if len(this.abs) > 0 {
+		this.abs = this.abs[:1]
+		this.abs[0] &= in
+		this.abs = RemoveMostSignificantZeroesFromWords(this.abs)
+	}
+	this.norm()
+}
+
+func (this *wordsInt) AndBytes(in []byte) {
+	if len(in) > 0 {
+		intIn := wordsInt{}
+		intIn.SetBytes(in)
+		intInWords := intIn.abs
+		if len(this.abs) < len(intInWords) {
+			for i := range this.abs {
+				this.abs[i] &= intInWords[i]
+			}
+
+		} else {
+			for i := range intInWords {
+
+				this.abs[i] &= intInWords[i]
+			}
+			this.abs = RemoveMostSignificantZeroesFromWords(this.abs[0:len(intInWords)])
+		}
+	} else {
+		for i := range this.abs {
+			this.abs[i] = 0
+		}
+	}
+	this.norm()
+}
+
+func (this *wordsInt) OrUint64(in uint64) {
+	if len(this.abs) == 0 {
+		this.abs = []uint64{0}
+	}
+	this.abs[0] |= in
+	this.norm()
+}
+
+func (this *wordsInt) OrBytes(in []byte) {
+	if len(in) != 0 {
+		intIn := wordsInt{}
+		intIn.SetBytes(in)
+		intInWords := intIn.abs
+
+		if len(this.abs) < len(intInWords) {
+			for i := range this.abs {
+				intInWords[i] |= this.abs[i]
+			}
+			this.abs = intInWords
+		} else {
+			for i := range intInWords {
+				this.abs[i] |= intInWords[i]
+			}
+		}
+		this.abs = RemoveMostSignificantZeroesFromWords(this.abs)
+	}
+	this.norm()
+}
+
+func (this *wordsInt) Add(in Backend) {
+	this.abs = addWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) AddUint64(in uint64) {
+	this.abs = addWords(this.abs, []uint64{in})
+	this.norm()
+}
+
+func (this *wordsInt) AddBytes(in []byte) {
+	if len(in) != 0 {
+		intIn := wordsInt{}
+		intIn.SetBytes(in)
+		this.Add(&intIn)
+	}
+}
+
+func (this *wordsInt) Sub(in Backend) {
+	x := this.abs
+	if this.width > 0 {
+		x = padWords(x, int(sizeInWordsFromBits(this.width)))
+	}
+	out, borrow := subWords(x, wordsFrom(in).abs)
+	if borrow != 0 && this.width == 0 {
+		panic(errSubUnderflow)
+	}
+	this.abs = out
+	this.norm()
+}
+
+func (this *wordsInt) SubUint64(in uint64) {
+	intIn := wordsInt{abs: []uint64{in}}
+	this.Sub(&intIn)
+}
+
+func (this *wordsInt) SubBytes(in []byte) {
+	if len(in) != 0 {
+		intIn := wordsInt{}
+		intIn.SetBytes(in)
+		this.Sub(&intIn)
+	}
+}
+
+func (this *wordsInt) Mul(in Backend) {
+	this.abs = mulWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) MulUint64(in uint64) {
+	this.abs = mulWords(this.abs, []uint64{in})
+	this.norm()
+}
+
+func (this *wordsInt) MulBytes(in []byte) {
+	if len(in) != 0 {
+		intIn := wordsInt{}
+		intIn.SetBytes(in)
+		this.Mul(&intIn)
+	} else {
+		this.abs = []uint64{}
+		this.norm()
+	}
+}
+
+func (this *wordsInt) Div(in Backend) {
+	this.abs, _ = divWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) Mod(in Backend) {
+	_, this.abs = divWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) DivMod(in Backend, mod Backend) {
+	q, r := divWords(this.abs, wordsFrom(in).abs)
+	this.abs = q
+	this.norm()
+	mod.SetWords(r)
+}
+
+func (this *wordsInt) DivUint64(in uint64) uint64 {
+	q, r := divWordsUint64(this.abs, in)
+	this.abs = q
+	this.norm()
+	return r
+}
+
+func (this *wordsInt) ModUint64(in uint64) uint64 {
+	_, r := divWordsUint64(this.abs, in)
+	return r
+}
+
+func (this *wordsInt) setWords(words []uint64) {
+	this.abs = words
+	this.norm()
+}
+
+func (this *wordsInt) SetBig(x *big.Int) {
+	if x.Sign() < 0 {
+		panic(errNegativeBig)
+	}
+	this.setWords(wordsFromBigWords(x.Bits()))
+}
+
+func (this *wordsInt) Big() *big.Int {
+	return new(big.Int).SetBits(bigWordsFromWords(this.abs))
+}
+
+// norm truncates abs to the width of this, removes the most significant
+// zero words and recalculates the size bookkeeping.
+func (this *wordsInt) norm() {
+	if this.width > 0 {
+		words := int(sizeInWordsFromBits(this.width))
+		if len(this.abs) >= words {
+			this.abs = this.abs[0:words]
+			if partial := this.width % 64; partial != 0 {
+				this.abs[words-1] &= 1<<partial - 1
+			}
+		}
+	}
+	this.abs = RemoveMostSignificantZeroesFromWords(this.abs)
+	this.sizeInWords = uint(len(this.abs))
+	if len(this.abs) == 0 {
+		this.sizeInBits = 0
+		this.sizeInBytes = 0
+		return
+	}
+	this.sizeInBits = uint(64*(len(this.abs)-1) + bits.Len64(this.abs[len(this.abs)-1]))
+	this.sizeInBytes = sizeInBytes(this.sizeInBits)
+}
+
+func (this *wordsInt) And(in Backend) {
+	this.abs = andWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) Or(in Backend) {
+	this.abs = orWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) Xor(in Backend) {
+	this.abs = xorWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) XorUint64(in uint64) {
+	this.abs = xorWords(this.abs, []uint64{in})
+	this.norm()
+}
+
+func (this *wordsInt) XorBytes(in []byte) {
+	if len(in) != 0 {
+		intIn := wordsInt{}
+		intIn.SetBytes(in)
+		this.Xor(&intIn)
+	}
+}
+
+func (this *wordsInt) AndNot(in Backend) {
+	this.abs = andNotWords(this.abs, wordsFrom(in).abs)
+	this.norm()
+}
+
+func (this *wordsInt) Not(width uint) {
+	if width == 0 {
+		width = this.width
+	}
+	if width == 0 {
+		panic(errNotUnbounded)
+	}
+	out := make([]uint64, sizeInWordsFromBits(width))
+	copy(out, this.abs)
+	for i := range out {
+		out[i] = ^out[i]
+	}
+	if partial := width % 64; partial != 0 {
+		out[len(out)-1] &= 1<<partial - 1
+	}
+	this.abs = out
+	this.norm()
+}
+
+func (this *wordsInt) RotateLeft(n, width uint) {
+	width = rotateWidth(width, this.width)
+	this.abs = rotateLeftWords(this.abs, n%width, width)
+	this.norm()
+}
+
+func (this *wordsInt) RotateRight(n, width uint) {
+	width = rotateWidth(width, this.width)
+	this.RotateLeft(width-n%width, width)
+}
+
+func (this *wordsInt) Bit(i uint) uint {
+	word := i / 64
+	if word >= uint(len(this.abs)) {
+		return 0
+	}
+	return uint(this.abs[word]>>(i%64)) & 1
+}
+
+func (this *wordsInt) SetBit(i uint, v uint) {
+	switch v {
+	case 0:
+		this.ClearBit(i)
+	case 1:
+		if this.width > 0 && i >= this.width {
+			return
+		}
+		this.abs = padWords(this.abs, int(i/64)+1)
+		this.abs[i/64] |= 1 << (i % 64)
+		this.norm()
+	default:
+		panic(errBitValue)
+	}
+}
+
+func (this *wordsInt) ClearBit(i uint) {
+	if word := i / 64; word < uint(len(this.abs)) {
+		this.abs[word] &^= 1 << (i % 64)
+		this.norm()
+	}
+}
+
+func (this *wordsInt) Field(hi, lo uint) Backend {
+	field := &wordsInt{width: fieldWidth(hi, lo)}
+	field.abs = fieldWords(this.abs, hi, lo)
+	field.norm()
+	return field
+}
+
+func (this *wordsInt) FieldUint64(hi, lo uint) uint64 {
+	if fieldWidth(hi, lo) > 64 {
+		panic(errFieldTooWide)
+	}
+	return (&wordsInt{abs: fieldWords(this.abs, hi, lo)}).Uint64()
+}
+
+func (this *wordsInt) SetField(hi, lo uint, v Backend) {
+	in := wordsFrom(v).abs
+	if v == Backend(this) {
+		in = append([]uint64(nil), in...)
+	}
+	this.abs = setFieldWords(this.abs, hi, lo, in)
+	this.norm()
+}
+
+func (this *wordsInt) SetFieldUint64(hi, lo uint, v uint64) {
+	this.abs = setFieldWords(this.abs, hi, lo, []uint64{v})
+	this.norm()
+}
+
+func (this *wordsInt) Cmp(in Backend) int {
+	return cmpWords(this.abs, wordsFrom(in).abs)
+}
+
+func (this *wordsInt) CmpUint64(in uint64) int {
+	return cmpWords(this.abs, []uint64{in})
+}
+
+func (this *wordsInt) IsZero() bool {
+	return len(RemoveMostSignificantZeroesFromWords(this.abs)) == 0
+}
+
+func (this *wordsInt) Text(base int) string {
+	return textWords(this.abs, base)
+}
@@ -0,0 +1,357 @@
+// Package lebigtest checks lebig backends against each other. Compare runs
+// every Int operation on two backends with the same random and edge case
+// operands and reports the first result on which they disagree.
+package lebigtest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lagarciag/lebig"
+)
+
+// Config controls a Compare run. Zero fields take their defaults.
+type Config struct {
+	// Seed of the operand generator, a run is reproduced by its seed.
+	Seed int64
+	// Iterations is the number of operand sets, 1000 by default.
+	Iterations int
+	// MaxWords is the largest operand size in 64 bit words, 8 by default.
+	MaxWords int
+}
+
+// Divergence is the first operation on which two backends disagree.
+type Divergence struct {
+	Seed      int64
+	Iteration int
+	Op        string
+	Width     uint
+	X, Y      []byte
+	N         uint
+	A, B      string
+	GotA      string
+	GotB      string
+}
+
+func (this *Divergence) Error() string {
+	return fmt.Sprintf("lebigtest: seed %d iteration %d: %s(width %d, x %x, y %x, n %d): %s gives %s, %s gives %s",
+		this.Seed, this.Iteration, this.Op, this.Width, this.X, this.Y, this.N, this.A, this.GotA, this.B, this.GotB)
+}
+
+// Compare runs every operation on a and b and returns a *Divergence for
+// the first one whose results differ, nil when they all agree.
+func Compare(a, b lebig.Backend, config Config) error {
+	if config.Iterations == 0 {
+		config.Iterations = 1000
+	}
+	if config.MaxWords == 0 {
+		config.MaxWords = 8
+	}
+	rnd := rand.New(rand.NewSource(config.Seed))
+	for i := 0; i < config.Iterations; i++ {
+		width := randomWidth(rnd, config.MaxWords)
+		x := randomOperand(rnd, config.MaxWords)
+		y := randomOperand(rnd, config.MaxWords)
+		n := randomCount(rnd, config.MaxWords)
+		for _, op := range ops {
+			gotA := run(op, a, width, x, y, n)
+			gotB := run(op, b, width, x, y, n)
+			if gotA != gotB {
+				return &Divergence{
+					Seed:      config.Seed,
+					Iteration: i,
+					Op:        op.name,
+					Width:     width,
+					X:         x,
+					Y:         y,
+					N:         n,
+					A:         a.Name(),
+					B:         b.Name(),
+					GotA:      gotA,
+					GotB:      gotB,
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Check compares a and b with a time based seed and fails t with the
+// divergence, the seed is logged so a failure can be replayed with Compare.
+func Check(t testing.TB, a, b lebig.Backend, iterations int) {
+	t.Helper()
+	seed := time.Now().UTC().UnixNano()
+	t.Logf("lebigtest seed: %d", seed)
+	if err := Compare(a, b, Config{Seed: seed, Iterations: iterations}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// run applies op to Ints of backend b and describes everything observable
+// afterwards, including a panic.
+func run(op operation, b lebig.Backend, width uint, xBytes, yBytes []byte, n uint) (out string) {
+	defer func() {
+		if r := recover(); r != nil {
+			out = fmt.Sprint("panic: ", r)
+		}
+	}()
+	x := lebig.NewWithBackend(b)
+	x.SetWidth(width)
+	x.SetBytes(xBytes)
+	y := lebig.NewWithBackend(b)
+	y.SetBytes(yBytes)
+	result := op.run(x, y, n)
+	return fmt.Sprintf("%s x=%x/%d y=%x", result, x.Bytes(), x.Width(), y.Bytes())
+}
+
+func randomWidth(rnd *rand.Rand, maxWords int) uint {
+	switch rnd.Intn(4) {
+	case 0:
+		return sized(edgeCounts[rnd.Intn(len(edgeCounts))])
+	case 1:
+		return uint(rnd.Intn(maxWords*64) + 1)
+	}
+	return 0
+}
+
+var edgeCounts = []uint{0, 1, 7, 8, 63, 64, 65, 127, 128, 129, 192, ^uint(0)>>1 + 1}
+
+// maxSized bounds the counts an operation allocates for, so that a bit
+// index beyond the range of int only reaches the operations that must not
+// allocate for it.
+const maxSized = 1 << 20
+
+func sized(n uint) uint {
+	return n % maxSized
+}
+
+func randomCount(rnd *rand.Rand, maxWords int) uint {
+	if rnd.Intn(2) == 0 {
+		return edgeCounts[rnd.Intn(len(edgeCounts))]
+	}
+	return uint(rnd.Intn(maxWords*64 + 64))
+}
+
+// randomOperand returns little endian bytes that are either random or one
+// of zero, a single word and values around a word boundary.
+func randomOperand(rnd *rand.Rand, maxWords int) []byte {
+	words := rnd.Intn(maxWords) + 1
+	switch rnd.Intn(8) {
+	case 0:
+		return nil
+	case 1:
+		return []byte{1}
+	case 2:
+		// 2^(64*words) - 1
+		out := make([]byte, words*8)
+		for i := range out {
+			out[i] = 0xff
+		}
+		return out
+	case 3:
+		// 2^(64*words)
+		out := make([]byte, words*8+1)
+		out[words*8] = 1
+		return out
+	case 4:
+		// a single random word
+		out := make([]byte, 8)
+		rnd.Read(out)
+		return out
+	}
+	out := make([]byte, rnd.Intn(words*8)+1)
+	rnd.Read(out)
+	return out
+}
+
+// operation is one Int method, run returns its results other than the
+// state of x and y.
+type operation struct {
+	name string
+	run  func(x, y *lebig.Int, n uint) string
+}
+
+func fieldBounds(n uint) (hi, lo uint) {
+	return n, n / 3
+}
+
+func describe(values ...interface{}) string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = fmt.Sprint(v)
+	}
+	return strings.Join(out, " ")
+}
+
+var ops = []operation{
+	{"Bytes", func(x, y *lebig.Int, n uint) string { return "" }},
+	{"AppendBytes", func(x, y *lebig.Int, n uint) string { return describe(x.AppendBytes(y.Bytes())) }},
+	{"PutBytes", func(x, y *lebig.Int, n uint) string {
+		buf := make([]byte, n%80)
+		written := x.PutBytes(buf)
+		return describe(buf, written)
+	}},
+	{"SetUint64", func(x, y *lebig.Int, n uint) string { x.SetUint64(y.Uint64()); return "" }},
+	{"Uint64", func(x, y *lebig.Int, n uint) string { return describe(x.Uint64()) }},
+	{"SetWidth", func(x, y *lebig.Int, n uint) string { x.SetWidth(sized(n)); return "" }},
+	{"SetWords", func(x, y *lebig.Int, n uint) string { x.SetWords(y.Words()); return "" }},
+	{"Words", func(x, y *lebig.Int, n uint) string { return describe(x.Words()) }},
+	{"Lengths", func(x, y *lebig.Int, n uint) string {
+		return describe(x.BitLen(), x.ByteLen(), x.WordLen(), x.TrailingZeros())
+	}},
+	{"SetBig", func(x, y *lebig.Int, n uint) string { x.SetBig(y.Big()); return "" }},
+	{"Big", func(x, y *lebig.Int, n uint) string { return x.Big().String() }},
+	{"Text", func(x, y *lebig.Int, n uint) string { return x.Text(int(n%35) + 2) }},
+	{"SmallShiftLeft", func(x, y *lebig.Int, n uint) string { x.SmallShiftLeft(n % 64); return "" }},
+	{"SmallShiftRight", func(x, y *lebig.Int, n uint) string { x.SmallShiftRight(n % 64); return "" }},
+	{"ShiftLeft", func(x, y *lebig.Int, n uint) string { x.ShiftLeft(sized(n)); return "" }},
+	{"ShiftRight", func(x, y *lebig.Int, n uint) string { x.ShiftRight(n); return "" }},
+	{"ArithShiftRight", func(x, y *lebig.Int, n uint) string { x.ArithShiftRight(n); return "" }},
+	{"SignExtend", func(x, y *lebig.Int, n uint) string { x.SignExtend(sized(n)/2, sized(n)); return "" }},
+	{"Truncate", func(x, y *lebig.Int, n uint) string { x.Truncate(sized(n)); return "" }},
+	{"AndUint64", func(x, y *lebig.Int, n uint) string { x.AndUint64(y.Uint64()); return "" }},
+	{"AndBytes", func(x, y *lebig.Int, n uint) string { x.AndBytes(y.Bytes()); return "" }},
+	{"OrUint64", func(x, y *lebig.Int, n uint) string { x.OrUint64(y.Uint64()); return "" }},
+	{"OrBytes", func(x, y *lebig.Int, n uint) string { x.OrBytes(y.Bytes()); return "" }},
+	{"And", func(x, y *lebig.Int, n uint) string { x.And(y); return "" }},
+	{"Or", func(x, y *lebig.Int, n uint) string { x.Or(y); return "" }},
+	{"Xor", func(x, y *lebig.Int, n uint) string { x.Xor(y); return "" }},
+	{"XorUint64", func(x, y *lebig.Int, n uint) string { x.XorUint64(y.Uint64()); return "" }},
+	{"XorBytes", func(x, y *lebig.Int, n uint) string { x.XorBytes(y.Bytes()); return "" }},
+	{"AndNot", func(x, y *lebig.Int, n uint) string { x.AndNot(y); return "" }},
+	{"Not", func(x, y *lebig.Int, n uint) string { x.Not(sized(n)); return "" }},
+	{"RotateLeft", func(x, y *lebig.Int, n uint) string { x.RotateLeft(sized(n), uint(y.Uint64()%300)); return "" }},
+	{"RotateRight", func(x, y *lebig.Int, n uint) string { x.RotateRight(sized(n), uint(y.Uint64()%300)); return "" }},
+	{"Add", func(x, y *lebig.Int, n uint) string { x.Add(y); return "" }},
+	{"AddUint64", func(x, y *lebig.Int, n uint) string { x.AddUint64(y.Uint64()); return "" }},
+	{"AddBytes", func(x, y *lebig.Int, n uint) string { x.AddBytes(y.Bytes()); return "" }},
+	{"Sub", func(x, y *lebig.Int, n uint) string { x.Sub(y); return "" }},
+	{"SubUint64", func(x, y *lebig.Int, n uint) string { x.SubUint64(y.Uint64()); return "" }},
+	{"SubBytes", func(x, y *lebig.Int, n uint) string { x.SubBytes(y.Bytes()); return "" }},
+	{"Mul", func(x, y *lebig.Int, n uint) string { x.Mul(y); return "" }},
+	{"MulUint64", func(x, y *lebig.Int, n uint) string { x.MulUint64(y.Uint64()); return "" }},
+	{"MulBytes", func(x, y *lebig.Int, n uint) string { x.MulBytes(y.Bytes()); return "" }},
+	{"Div", func(x, y *lebig.Int, n uint) string { x.Div(y); return "" }},
+	{"Mod", func(x, y *lebig.Int, n uint) string { x.Mod(y); return "" }},
+	{"DivMod", func(x, y *lebig.Int, n uint) string {
+		mod := lebig.NewWithBackend(x.Backend())
+		mod.SetWidth(sized(n))
+		x.DivMod(y, mod)
+		return describe(mod.Bytes())
+	}},
+	{"DivUint64", func(x, y *lebig.Int, n uint) string { return describe(x.DivUint64(y.Uint64())) }},
+	{"ModUint64", func(x, y *lebig.Int, n uint) string { return describe(x.ModUint64(y.Uint64())) }},
+	{"Bit", func(x, y *lebig.Int, n uint) string { return describe(x.Bit(n), x.TestBit(n)) }},
+	{"SetBit", func(x, y *lebig.Int, n uint) string { x.SetBit(sized(n), y.Bit(0)); return "" }},
+	{"SetBitZero", func(x, y *lebig.Int, n uint) string { x.SetBit(n, 0); return "" }},
+	{"SetBitValue", func(x, y *lebig.Int, n uint) string { x.SetBit(sized(n), uint(y.Uint64()%4)); return "" }},
+	{"ClearBit", func(x, y *lebig.Int, n uint) string { x.ClearBit(n); return "" }},
+	{"FlipBit", func(x, y *lebig.Int, n uint) string { x.FlipBit(sized(n)); return "" }},
+	{"Field", func(x, y *lebig.Int, n uint) string {
+		field := x.Field(fieldBounds(n))
+		return describe(field.Words(), field.Width())
+	}},
+	{"FieldUint64", func(x, y *lebig.Int, n uint) string {
+		return describe(x.FieldUint64(n%64+n/2, n/2))
+	}},
+	{"SetField", func(x, y *lebig.Int, n uint) string {
+		hi, lo := fieldBounds(sized(n))
+		x.SetField(hi, lo, y)
+		return ""
+	}},
+	{"SetFieldUint64", func(x, y *lebig.Int, n uint) string {
+		x.SetFieldUint64(sized(n)%64+sized(n)/2, sized(n)/2, y.Uint64())
+		return ""
+	}},
+	{"Cmp", func(x, y *lebig.Int, n uint) string {
+		return describe(x.Cmp(y), x.Equal(y), x.Less(y), x.CmpUint64(y.Uint64()))
+	}},
+	{"IsZero", func(x, y *lebig.Int, n uint) string { return describe(x.IsZero(), x.Sign()) }},
+
+	{"SetBytesBE", func(x, y *lebig.Int, n uint) string { x.SetBytesBE(y.Bytes()); return "" }},
+	{"BytesBE", func(x, y *lebig.Int, n uint) string { return describe(x.BytesBE()) }},
+	{"SetWords32", func(x, y *lebig.Int, n uint) string {
+		x.SetWords32(y.Words32(), byteOrders[n%2])
+		return ""
+	}},
+	{"Words32", func(x, y *lebig.Int, n uint) string { return describe(x.Words32()) }},
+	{"FillBytes", func(x, y *lebig.Int, n uint) string {
+		return describe(x.FillBytes(make([]byte, n%80), byteOrders[n%2]))
+	}},
+
+	{"MarshalBinary", func(x, y *lebig.Int, n uint) string {
+		data, err := x.MarshalBinary()
+		return describe(data, err, y.UnmarshalBinary(data))
+	}},
+	{"UnmarshalBinary", func(x, y *lebig.Int, n uint) string { return describe(x.UnmarshalBinary(y.Bytes())) }},
+	{"MarshalText", func(x, y *lebig.Int, n uint) string {
+		text, err := x.MarshalText()
+		return describe(string(text), err, y.UnmarshalText(text))
+	}},
+	{"UnmarshalText", func(x, y *lebig.Int, n uint) string { return describe(x.UnmarshalText([]byte(y.Text(10)))) }},
+	{"MarshalJSON", func(x, y *lebig.Int, n uint) string {
+		data, err := x.MarshalJSONFormat(lebig.JSONEncoding(n % 3))
+		return describe(string(data), err, y.UnmarshalJSON(data))
+	}},
+
+	{"AppendULEB128", func(x, y *lebig.Int, n uint) string {
+		data := x.AppendULEB128(y.Bytes())
+		return describe(data, y.ReadULEB128(bytes.NewReader(data[len(y.Bytes()):]), n%200))
+	}},
+	{"AppendSLEB128", func(x, y *lebig.Int, n uint) string {
+		data := x.AppendSLEB128(y.Bytes())
+		return describe(data, y.ReadSLEB128(bytes.NewReader(data[len(y.Bytes()):]), n%200))
+	}},
+	{"ReadULEB128", func(x, y *lebig.Int, n uint) string {
+		return describe(x.ReadULEB128(bytes.NewReader(y.Bytes()), n%200))
+	}},
+	{"ReadSLEB128", func(x, y *lebig.Int, n uint) string {
+		return describe(x.ReadSLEB128(bytes.NewReader(y.Bytes()), n%200))
+	}},
+
+	{"SetString", func(x, y *lebig.Int, n uint) string {
+		base := int(n%35) + 2
+		_, ok := x.SetString(y.Text(base), base)
+		return describe(ok)
+	}},
+	{"Parse", func(x, y *lebig.Int, n uint) string {
+		parsed, err := lebig.Parse(fmt.Sprintf("%#x", x))
+		if err != nil {
+			return describe(err)
+		}
+		return describe(parsed.Cmp(x))
+	}},
+	{"Format", func(x, y *lebig.Int, n uint) string {
+		return fmt.Sprintf("%b %o %O %d %x %X %#x %+d %08x %.5x %-9d| %v %s %q", x, x, x, x, x, x, x, x, x, x, x, x, x, x)
+	}},
+	{"String", func(x, y *lebig.Int, n uint) string { return x.String() }},
+	{"FormatVerilog", func(x, y *lebig.Int, n uint) string {
+		return x.FormatVerilog(n%300, "hdbo"[n%4])
+	}},
+
+	// the receiver is also the operand
+	{"AddAliased", func(x, y *lebig.Int, n uint) string { x.Add(x); return "" }},
+	{"SubAliased", func(x, y *lebig.Int, n uint) string { x.Sub(x); return "" }},
+	{"MulAliased", func(x, y *lebig.Int, n uint) string { x.Mul(x); return "" }},
+	{"DivAliased", func(x, y *lebig.Int, n uint) string { x.Div(x); return "" }},
+	{"ModAliased", func(x, y *lebig.Int, n uint) string { x.Mod(x); return "" }},
+	{"DivModAliasedIn", func(x, y *lebig.Int, n uint) string { x.DivMod(x, y); return "" }},
+	{"DivModAliasedMod", func(x, y *lebig.Int, n uint) string { x.DivMod(y, x); return "" }},
+	{"AndAliased", func(x, y *lebig.Int, n uint) string { x.And(x); return "" }},
+	{"OrAliased", func(x, y *lebig.Int, n uint) string { x.Or(x); return "" }},
+	{"XorAliased", func(x, y *lebig.Int, n uint) string { x.Xor(x); return "" }},
+	{"AndNotAliased", func(x, y *lebig.Int, n uint) string { x.AndNot(x); return "" }},
+	{"CmpAliased", func(x, y *lebig.Int, n uint) string { return describe(x.Cmp(x), x.Equal(x), x.Less(x)) }},
+	{"SetFieldAliased", func(x, y *lebig.Int, n uint) string {
+		hi, lo := fieldBounds(sized(n))
+		x.SetField(hi, lo, x)
+		return ""
+	}},
+}
+
+var byteOrders = []binary.ByteOrder{binary.LittleEndian, binary.BigEndian}
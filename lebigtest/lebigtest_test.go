@@ -0,0 +1,45 @@
+package lebigtest_test
+
+import (
+	"testing"
+
+	"github.com/lagarciag/lebig"
+	"github.com/lagarciag/lebig/lebigtest"
+)
+
+func TestBackendsAgree(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	lebigtest.Check(t, lebig.BigBackend, lebig.WordsBackend, 2000)
+}
+
+// brokenBackend gets AddUint64 wrong for odd operands.
+type brokenBackend struct {
+	lebig.Backend
+}
+
+func (this *brokenBackend) New() lebig.Backend {
+	return &brokenBackend{this.Backend.New()}
+}
+
+func (this *brokenBackend) AddUint64(in uint64) {
+	this.Backend.AddUint64(in &^ 1)
+}
+
+func TestCompareFindsDivergence(t *testing.T) {
+	t.Parallel()
+	t.Log(t.Name())
+	broken := &brokenBackend{lebig.WordsBackend}
+	err := lebigtest.Compare(lebig.BigBackend, broken, lebigtest.Config{Seed: 1})
+	divergence, ok := err.(*lebigtest.Divergence)
+	if !ok {
+		t.Fatalf("expected a divergence, got %v", err)
+	}
+	if divergence.Op != "AddUint64" || divergence.Seed != 1 {
+		t.Errorf("unexpected divergence: %v", divergence)
+	}
+	again := lebigtest.Compare(lebig.BigBackend, broken, lebigtest.Config{Seed: 1})
+	if again.Error() != err.Error() {
+		t.Errorf("seed does not reproduce: %v and %v", err, again)
+	}
+}